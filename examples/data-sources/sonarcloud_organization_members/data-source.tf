@@ -0,0 +1,5 @@
+data "sonarcloud_organization_members" "all" {}
+
+data "sonarcloud_organization_members" "github_users" {
+  query = "@github"
+}
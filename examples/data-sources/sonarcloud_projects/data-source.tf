@@ -1 +1,15 @@
 data "sonarcloud_projects" "all" {}
+
+data "sonarcloud_projects" "team_a" {
+  tags = ["team-a"]
+}
+
+// Find all projects that have been provisioned, but never analyzed
+data "sonarcloud_projects" "never_analyzed" {
+  on_provisioned_only = true
+}
+
+data "sonarcloud_projects" "private_services" {
+  query      = "service"
+  visibility = "private"
+}
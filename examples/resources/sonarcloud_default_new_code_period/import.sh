@@ -0,0 +1,2 @@
+# import the default new code period using <organization>
+terraform import "sonarcloud_default_new_code_period.default" "example_organization"
@@ -0,0 +1,3 @@
+resource "sonarcloud_default_new_code_period" "default" {
+  type = "PREVIOUS_VERSION"
+}
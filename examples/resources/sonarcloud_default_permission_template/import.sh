@@ -0,0 +1,2 @@
+# import the default permission template using <organization>
+terraform import "sonarcloud_default_permission_template.default" "example_organization"
@@ -0,0 +1,8 @@
+resource "sonarcloud_permission_template" "default" {
+  name        = "Default projects"
+  description = "Permissions for all projects that do not match another template"
+}
+
+resource "sonarcloud_default_permission_template" "default" {
+  template_id = sonarcloud_permission_template.default.id
+}
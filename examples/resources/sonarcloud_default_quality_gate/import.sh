@@ -0,0 +1,2 @@
+# import the default quality gate using <organization>
+terraform import "sonarcloud_default_quality_gate.default" "example_organization"
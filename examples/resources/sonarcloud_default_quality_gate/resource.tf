@@ -0,0 +1,7 @@
+resource "sonarcloud_quality_gate" "awesome" {
+  name = "My Awesome Quality Gate"
+}
+
+resource "sonarcloud_default_quality_gate" "default" {
+  gate_id = sonarcloud_quality_gate.awesome.id
+}
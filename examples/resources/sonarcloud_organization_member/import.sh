@@ -0,0 +1,2 @@
+# import an organization member using <login>
+terraform import "sonarcloud_organization_member.new_engineer" "new-engineer@github"
@@ -0,0 +1,8 @@
+resource "sonarcloud_organization_member" "new_engineer" {
+  login = "new-engineer@github"
+}
+
+resource "sonarcloud_user_group_member" "new_engineer" {
+  group = "backend"
+  login = sonarcloud_organization_member.new_engineer.login
+}
@@ -0,0 +1,2 @@
+# import a permission template using <template_id>
+terraform import "sonarcloud_permission_template.backend" "AU-TpxcA-iU5OvuD2FL1"
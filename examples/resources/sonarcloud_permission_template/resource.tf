@@ -0,0 +1,5 @@
+resource "sonarcloud_permission_template" "backend" {
+  name                = "Backend projects"
+  description         = "Permissions for the projects of the backend team"
+  project_key_pattern = "example_backend_.*"
+}
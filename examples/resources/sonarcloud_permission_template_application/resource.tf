@@ -0,0 +1,20 @@
+resource "sonarcloud_permission_template" "backend" {
+  name                = "Backend projects"
+  project_key_pattern = "example_backend_.*"
+}
+
+resource "sonarcloud_permission_template_group_permissions" "backend" {
+  template_id = sonarcloud_permission_template.backend.id
+  name        = "backend"
+  permissions = ["user", "codeviewer", "issueadmin"]
+}
+
+// Re-apply the template to the existing backend projects whenever its group permissions change
+resource "sonarcloud_permission_template_application" "backend" {
+  template_id = sonarcloud_permission_template.backend.id
+  query       = "example_backend_"
+
+  triggers = {
+    permissions = join(",", sonarcloud_permission_template_group_permissions.backend.permissions)
+  }
+}
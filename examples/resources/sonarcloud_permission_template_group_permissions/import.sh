@@ -0,0 +1,2 @@
+# import the permissions of a group in a permission template using <template_id>,<group_name>
+terraform import "sonarcloud_permission_template_group_permissions.backend" "AU-TpxcA-iU5OvuD2FL1,backend"
@@ -0,0 +1,14 @@
+resource "sonarcloud_permission_template" "backend" {
+  name                = "Backend projects"
+  project_key_pattern = "example_backend_.*"
+}
+
+resource "sonarcloud_user_group" "backend" {
+  name = "backend"
+}
+
+resource "sonarcloud_permission_template_group_permissions" "backend" {
+  template_id = sonarcloud_permission_template.backend.id
+  name        = sonarcloud_user_group.backend.name
+  permissions = ["user", "codeviewer", "issueadmin"]
+}
@@ -0,0 +1,2 @@
+# import the project creator permissions of a permission template using <template_id>
+terraform import "sonarcloud_permission_template_project_creator_permissions.backend" "AU-TpxcA-iU5OvuD2FL1"
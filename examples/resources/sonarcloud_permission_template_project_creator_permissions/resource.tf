@@ -0,0 +1,9 @@
+resource "sonarcloud_permission_template" "backend" {
+  name                = "Backend projects"
+  project_key_pattern = "example_backend_.*"
+}
+
+resource "sonarcloud_permission_template_project_creator_permissions" "backend" {
+  template_id = sonarcloud_permission_template.backend.id
+  permissions = ["admin"]
+}
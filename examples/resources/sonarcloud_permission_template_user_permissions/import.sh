@@ -0,0 +1,2 @@
+# import the permissions of a user in a permission template using <template_id>,<login>
+terraform import "sonarcloud_permission_template_user_permissions.lead" "AU-TpxcA-iU5OvuD2FL1,lead@github"
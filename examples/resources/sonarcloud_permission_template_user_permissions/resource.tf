@@ -0,0 +1,10 @@
+resource "sonarcloud_permission_template" "backend" {
+  name                = "Backend projects"
+  project_key_pattern = "example_backend_.*"
+}
+
+resource "sonarcloud_permission_template_user_permissions" "lead" {
+  template_id = sonarcloud_permission_template.backend.id
+  login       = "lead@github"
+  permissions = ["admin", "securityhotspotadmin"]
+}
@@ -0,0 +1,5 @@
+# import all permissions of the organization using <organization>
+terraform import "sonarcloud_permissions.organization" "example_organization"
+
+# import all permissions of a specific project using <project_key>
+terraform import "sonarcloud_permissions.example_project" "example_project"
@@ -0,0 +1,31 @@
+// Manage all permissions of the organization
+resource "sonarcloud_permissions" "organization" {
+  permissions = {
+    admin = {
+      groups = ["Owners"]
+    }
+    scan = {
+      groups = ["Owners", "Members"]
+    }
+    provisioning = {
+      groups = ["Owners"]
+      users  = ["ci-bot@github"]
+    }
+  }
+}
+
+// Manage all permissions of a specific project
+resource "sonarcloud_permissions" "example_project" {
+  project_key = "example_project"
+  permissions = {
+    admin = {
+      groups = ["Owners"]
+    }
+    user = {
+      groups = ["Members"]
+    }
+    codeviewer = {
+      groups = ["Members"]
+    }
+  }
+}
@@ -2,4 +2,7 @@ resource "sonarcloud_project" "example_project" {
   key        = "my-unique-project-key"
   name       = "My not-unique project name"
   visibility = "private"
+
+  // Disable automatic analysis to analyze the project in a CI pipeline
+  automatic_analysis = false
 }
@@ -0,0 +1,2 @@
+# import the Azure DevOps binding of a project using <project_key>
+terraform import "sonarcloud_project_azure_binding.example_project" "example_project"
@@ -0,0 +1,2 @@
+# import the Bitbucket Cloud binding of a project using <project_key>
+terraform import "sonarcloud_project_bitbucket_cloud_binding.example_project" "example_project"
@@ -0,0 +1,11 @@
+resource "sonarcloud_project" "example_project" {
+  key        = "example_project"
+  name       = "Example project"
+  visibility = "private"
+}
+
+resource "sonarcloud_project_bitbucket_cloud_binding" "example_project" {
+  project_key = sonarcloud_project.example_project.key
+  repository  = "example-project"
+  monorepo    = true
+}
@@ -0,0 +1,2 @@
+# import the GitHub binding of a project using <project_key>
+terraform import "sonarcloud_project_github_binding.example_project" "example_project"
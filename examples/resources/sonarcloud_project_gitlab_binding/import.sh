@@ -0,0 +1,2 @@
+# import the GitLab binding of a project using <project_key>
+terraform import "sonarcloud_project_gitlab_binding.example_project" "example_project"
@@ -0,0 +1,5 @@
+# import the new code period of a project using <project_key>
+terraform import "sonarcloud_project_new_code_period.example_project" "example_project"
+
+# import the new code period of a branch using <project_key>,<branch>
+terraform import "sonarcloud_project_new_code_period.example_project_release" "example_project,release"
@@ -0,0 +1,18 @@
+resource "sonarcloud_project" "example_project" {
+  key        = "example_project"
+  name       = "Example project"
+  visibility = "private"
+}
+
+resource "sonarcloud_project_new_code_period" "example_project" {
+  project_key = sonarcloud_project.example_project.key
+  type        = "NUMBER_OF_DAYS"
+  value       = "30"
+}
+
+resource "sonarcloud_project_new_code_period" "example_project_release" {
+  project_key = sonarcloud_project.example_project.key
+  branch      = "release"
+  type        = "REFERENCE_BRANCH"
+  value       = "main"
+}
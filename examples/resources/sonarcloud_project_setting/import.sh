@@ -0,0 +1,2 @@
+# import a project setting using <project_key>,<setting_key>
+terraform import "sonarcloud_project_setting.exclusions" "example_project,sonar.exclusions"
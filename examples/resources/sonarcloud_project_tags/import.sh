@@ -0,0 +1,2 @@
+# import the tags of a project using <project_key>
+terraform import "sonarcloud_project_tags.example_project" "example_project"
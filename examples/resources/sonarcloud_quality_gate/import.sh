@@ -1,2 +1,8 @@
-# import a quality gate using <quality gate name>
+# import a quality gate using <quality gate id>
+terraform import "sonarcloud_quality_gate.very_strict" "12345"
+
+# or import a quality gate using <quality gate name>
 terraform import "sonarcloud_quality_gate.very_strict" "Very Strict"
+
+# a quality gate with a numeric name is imported using name:<quality gate name>
+terraform import "sonarcloud_quality_gate.very_strict" "name:2022"
@@ -1,6 +1,5 @@
 resource "sonarcloud_quality_gate" "awesome" {
-  name       = "My Awesome Quality Gate"
-  is_default = true
+  name = "My Awesome Quality Gate"
   conditions = [
     // Less than 100% coverage
     {
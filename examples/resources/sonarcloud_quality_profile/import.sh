@@ -0,0 +1,2 @@
+# import a quality profile using <profile_key>
+terraform import "sonarcloud_quality_profile.example_quality_profile" "AYK3x5kBQ7c6cZqXnFmR"
@@ -0,0 +1,20 @@
+resource "sonarcloud_quality_profile" "example_quality_profile" {
+  name       = "My Java Profile"
+  language   = "java"
+  parent     = "Sonar way"
+  is_default = true
+
+  rules = [
+    {
+      rule     = "java:S1144"
+      severity = "MAJOR"
+    },
+    {
+      rule     = "java:S138"
+      severity = "CRITICAL"
+      params = {
+        max = "50"
+      }
+    },
+  ]
+}
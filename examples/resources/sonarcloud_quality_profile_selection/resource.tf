@@ -0,0 +1,13 @@
+resource "sonarcloud_quality_profile" "team_java" {
+  name     = "Team Java"
+  language = "java"
+  parent   = "Sonar way"
+}
+
+data "sonarcloud_projects" "all" {}
+
+resource "sonarcloud_quality_profile_selection" "example_quality_profile_selection" {
+  name         = sonarcloud_quality_profile.team_java.name
+  language     = sonarcloud_quality_profile.team_java.language
+  project_keys = [for project in data.sonarcloud_projects.all.projects : project.key if project.name == "My Awesome Project"]
+}
@@ -0,0 +1,2 @@
+# import all members of a group using <group_name>
+terraform import "sonarcloud_user_group_members.backend" "backend"
@@ -0,0 +1,11 @@
+resource "sonarcloud_user_group" "backend" {
+  name = "backend"
+}
+
+resource "sonarcloud_user_group_members" "backend" {
+  group = sonarcloud_user_group.backend.name
+  logins = [
+    "alice@github",
+    "bob@github",
+  ]
+}
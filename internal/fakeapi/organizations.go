@@ -0,0 +1,357 @@
+package fakeapi
+
+import (
+	"strconv"
+)
+
+// user is a SonarCloud user, which may or may not be a member of the organization
+type user struct {
+	login string
+	name  string
+}
+
+func (u *user) json() map[string]interface{} {
+	return map[string]interface{}{
+		"login":  u.login,
+		"name":   u.name,
+		"avatar": "00000000000000000000000000000000",
+	}
+}
+
+// group is a user group of the organization
+type group struct {
+	id          int
+	name        string
+	description string
+	isDefault   bool
+	members     map[string]bool
+}
+
+func (g *group) json() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           g.id,
+		"name":         g.name,
+		"description":  g.description,
+		"membersCount": len(g.members),
+		"default":      g.isDefault,
+	}
+}
+
+func (s *Server) addOrganizationRoutes() {
+	s.handle("GET", "/organizations/search", s.searchOrganizations)
+	s.handle("GET", "/organizations/search_members", s.searchMembers)
+	s.handle("POST", "/organizations/add_member", s.addMember)
+	s.handle("POST", "/organizations/remove_member", s.removeMember)
+
+	s.handle("GET", "/user_groups/search", s.searchGroups)
+	s.handle("POST", "/user_groups/create", s.createGroup)
+	s.handle("POST", "/user_groups/update", s.updateGroup)
+	s.handle("POST", "/user_groups/delete", s.deleteGroup)
+	s.handle("GET", "/user_groups/users", s.groupUsers)
+	s.handle("POST", "/user_groups/add_user", s.addGroupUser)
+	s.handle("POST", "/user_groups/remove_user", s.removeGroupUser)
+
+	s.handle("GET", "/user_tokens/search", s.searchTokens)
+	s.handle("POST", "/user_tokens/generate", s.generateToken)
+	s.handle("POST", "/user_tokens/revoke", s.revokeToken)
+}
+
+// findUser returns the user with the login
+func (s *Server) findUser(login string) (*user, error) {
+	u, ok := s.users[login]
+	if !ok {
+		return nil, notFound("User with login '%s' has not been found", login)
+	}
+	return u, nil
+}
+
+// findMember returns the member of the organization with the login
+func (s *Server) findMember(login string) (*user, error) {
+	u, err := s.findUser(login)
+	if err != nil {
+		return nil, err
+	}
+	if !s.members[login] {
+		return nil, badRequest("User '%s' is not member of organization '%s'", login, Organization)
+	}
+	return u, nil
+}
+
+// findGroup returns the group that is identified by the id or name parameter of the request
+func (s *Server) findGroup(r request, idParam, nameParam string) (*group, error) {
+	if id := r.param(idParam); id != "" {
+		for _, g := range s.groups {
+			if strconv.Itoa(g.id) == id {
+				return g, nil
+			}
+		}
+		return nil, notFound("No group with id '%s'", id)
+	}
+	if err := r.required(nameParam); err != nil {
+		return nil, err
+	}
+	g, ok := s.groups[r.param(nameParam)]
+	if !ok {
+		return nil, notFound("No group with name '%s' in organization '%s'", r.param(nameParam), Organization)
+	}
+	return g, nil
+}
+
+// searchOrganizations returns the organization of the fake, if it is one of the requested organizations
+func (s *Server) searchOrganizations(r request) (interface{}, error) {
+	organizations := make([]map[string]interface{}, 0)
+	for _, key := range r.list("organizations") {
+		if key == Organization {
+			organizations = append(organizations, map[string]interface{}{"key": Organization, "name": "Fake Organization"})
+		}
+	}
+
+	organizations, p := page(r, organizations)
+	return map[string]interface{}{"organizations": organizations, "paging": p}, nil
+}
+
+func (s *Server) searchMembers(r request) (interface{}, error) {
+	var users []map[string]interface{}
+	for _, login := range sortedKeys(s.members) {
+		u := s.users[login]
+		if matches(r.param("q"), u.login, u.name) {
+			users = append(users, u.json())
+		}
+	}
+
+	users, p := page(r, users)
+	return map[string]interface{}{"users": users, "paging": p}, nil
+}
+
+func (s *Server) addMember(r request) (interface{}, error) {
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findUser(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	s.members[u.login] = true
+	for _, g := range s.groups {
+		if g.isDefault {
+			g.members[u.login] = true
+		}
+	}
+	return map[string]interface{}{"user": u.json()}, nil
+}
+
+func (s *Server) removeMember(r request) (interface{}, error) {
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findMember(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.members, u.login)
+	for _, g := range s.groups {
+		delete(g.members, u.login)
+	}
+	s.removeGrantee(userGrantee(u.login))
+	return nil, nil
+}
+
+func (s *Server) searchGroups(r request) (interface{}, error) {
+	var groups []map[string]interface{}
+	for _, name := range sortedKeys(s.groups) {
+		if matches(r.param("q"), name) {
+			groups = append(groups, s.groups[name].json())
+		}
+	}
+
+	groups, p := page(r, groups)
+	return map[string]interface{}{"groups": groups, "paging": p}, nil
+}
+
+func (s *Server) createGroup(r request) (interface{}, error) {
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+	name := r.param("name")
+	if _, ok := s.groups[name]; ok || name == anyone {
+		return nil, badRequest("Group '%s' already exists", name)
+	}
+
+	g := &group{id: s.newID(), name: name, description: r.param("description"), members: map[string]bool{}}
+	s.groups[name] = g
+
+	response := g.json()
+	response["organization"] = Organization
+	return map[string]interface{}{"group": response}, nil
+}
+
+func (s *Server) updateGroup(r request) (interface{}, error) {
+	if err := r.required("id"); err != nil {
+		return nil, err
+	}
+	g, err := s.findGroup(r, "id", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if name := r.param("name"); name != "" && name != g.name {
+		if g.isDefault {
+			return nil, badRequest("Default group '%s' cannot be used to perform this action", g.name)
+		}
+		if _, ok := s.groups[name]; ok || name == anyone {
+			return nil, badRequest("Group '%s' already exists", name)
+		}
+		s.renameGrantee(groupGrantee(g.name), groupGrantee(name))
+		delete(s.groups, g.name)
+		g.name = name
+		s.groups[name] = g
+	}
+	if r.has("description") {
+		g.description = r.param("description")
+	}
+	return map[string]interface{}{"group": g.json()}, nil
+}
+
+func (s *Server) deleteGroup(r request) (interface{}, error) {
+	g, err := s.findGroup(r, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	if g.isDefault {
+		return nil, badRequest("Default group '%s' cannot be used to perform this action", g.name)
+	}
+
+	delete(s.groups, g.name)
+	s.removeGrantee(groupGrantee(g.name))
+	return nil, nil
+}
+
+func (s *Server) groupUsers(r request) (interface{}, error) {
+	g, err := s.findGroup(r, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("selected", "selected", "deselected", "all"); err != nil {
+		return nil, err
+	}
+	selected := r.param("selected")
+	if selected == "" {
+		selected = "selected"
+	}
+
+	var users []map[string]interface{}
+	for _, login := range sortedKeys(s.members) {
+		u := s.users[login]
+		isMember := g.members[login]
+		if (selected == "selected" && !isMember) || (selected == "deselected" && isMember) {
+			continue
+		}
+		if !matches(r.param("q"), u.login, u.name) {
+			continue
+		}
+		users = append(users, map[string]interface{}{"login": u.login, "name": u.name, "selected": isMember})
+	}
+
+	users, p := page(r, users)
+	return map[string]interface{}{"users": users, "p": p.PageIndex, "ps": p.PageSize, "total": p.Total}, nil
+}
+
+func (s *Server) addGroupUser(r request) (interface{}, error) {
+	g, err := s.findGroup(r, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findMember(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	g.members[u.login] = true
+	return nil, nil
+}
+
+func (s *Server) removeGroupUser(r request) (interface{}, error) {
+	g, err := s.findGroup(r, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	if _, err := s.findUser(r.param("login")); err != nil {
+		return nil, err
+	}
+
+	delete(g.members, r.param("login"))
+	return nil, nil
+}
+
+// tokenLogin returns the login of the user whose tokens are managed by the request, which defaults to the user of the
+// token of the request
+func (s *Server) tokenLogin(r request) (string, error) {
+	login := r.param("login")
+	if login == "" {
+		login = TokenUserLogin
+	}
+	if _, err := s.findUser(login); err != nil {
+		return "", err
+	}
+	return login, nil
+}
+
+func (s *Server) searchTokens(r request) (interface{}, error) {
+	login, err := s.tokenLogin(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]map[string]interface{}, 0)
+	for _, name := range sortedKeys(s.tokens[login]) {
+		tokens = append(tokens, map[string]interface{}{"name": name, "createdAt": s.tokens[login][name]})
+	}
+	return map[string]interface{}{"login": login, "userTokens": tokens}, nil
+}
+
+func (s *Server) generateToken(r request) (interface{}, error) {
+	login, err := s.tokenLogin(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+	name := r.param("name")
+	if _, ok := s.tokens[login][name]; ok {
+		return nil, badRequest("A user token for login '%s' and name '%s' already exists", login, name)
+	}
+
+	if s.tokens[login] == nil {
+		s.tokens[login] = make(map[string]string)
+	}
+	created := now()
+	s.tokens[login][name] = created
+	return map[string]interface{}{
+		"login":     login,
+		"name":      name,
+		"token":     s.newKey("token"),
+		"createdAt": created,
+	}, nil
+}
+
+func (s *Server) revokeToken(r request) (interface{}, error) {
+	login, err := s.tokenLogin(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+
+	delete(s.tokens[login], r.param("name"))
+	return nil, nil
+}
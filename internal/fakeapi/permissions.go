@@ -0,0 +1,623 @@
+package fakeapi
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// globalPermissions are the permissions that can be granted on the organization
+var globalPermissions = []string{"admin", "gateadmin", "profileadmin", "provisioning", "scan"}
+
+// projectPermissions are the permissions that can be granted on a project or a permission template
+var projectPermissions = []string{"admin", "codeviewer", "issueadmin", "securityhotspotadmin", "scan", "user"}
+
+// anyone is the name of the virtual group that contains every user
+const anyone = "Anyone"
+
+// grantee is a user or group that permissions are granted to
+type grantee struct {
+	group bool
+	name  string
+}
+
+func userGrantee(login string) grantee {
+	return grantee{name: login}
+}
+
+func groupGrantee(name string) grantee {
+	return grantee{group: true, name: name}
+}
+
+// grants are the permissions that are granted on the organization, a project or a permission template
+type grants map[grantee]map[string]bool
+
+func newGrants() grants {
+	return make(grants)
+}
+
+func (g grants) add(who grantee, permission string) {
+	if g[who] == nil {
+		g[who] = make(map[string]bool)
+	}
+	g[who][permission] = true
+}
+
+func (g grants) remove(who grantee, permission string) {
+	delete(g[who], permission)
+	if len(g[who]) == 0 {
+		delete(g, who)
+	}
+}
+
+// of returns the permissions that are granted to the grantee in order
+func (g grants) of(who grantee) []string {
+	permissions := make([]string, 0, len(g[who]))
+	for permission := range g[who] {
+		permissions = append(permissions, permission)
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+func (g grants) copy() grants {
+	c := newGrants()
+	for who, permissions := range g {
+		for permission := range permissions {
+			c.add(who, permission)
+		}
+	}
+	return c
+}
+
+// permissionTemplate is a permission template of the organization
+type permissionTemplate struct {
+	id                string
+	name              string
+	description       string
+	projectKeyPattern string
+	permissions       grants
+	projectCreator    map[string]bool
+}
+
+func (t *permissionTemplate) json() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                t.id,
+		"name":              t.name,
+		"description":       t.description,
+		"projectKeyPattern": t.projectKeyPattern,
+		"createdAt":         "2022-01-01T00:00:00+0000",
+		"updatedAt":         "2022-01-01T00:00:00+0000",
+	}
+}
+
+func (s *Server) addPermissionRoutes() {
+	s.handle("GET", "/permissions/users", s.permissionUsers)
+	s.handle("GET", "/permissions/groups", s.permissionGroups)
+	s.handle("POST", "/permissions/add_user", s.addUserPermission)
+	s.handle("POST", "/permissions/remove_user", s.removeUserPermission)
+	s.handle("POST", "/permissions/add_group", s.addGroupPermission)
+	s.handle("POST", "/permissions/remove_group", s.removeGroupPermission)
+
+	s.handle("GET", "/permissions/search_templates", s.searchTemplates)
+	s.handle("POST", "/permissions/create_template", s.createTemplate)
+	s.handle("POST", "/permissions/update_template", s.updateTemplate)
+	s.handle("POST", "/permissions/delete_template", s.deleteTemplate)
+	s.handle("POST", "/permissions/set_default_template", s.setDefaultTemplate)
+	s.handle("GET", "/permissions/template_users", s.templateUsers)
+	s.handle("GET", "/permissions/template_groups", s.templateGroups)
+	s.handle("POST", "/permissions/add_user_to_template", s.addUserToTemplate)
+	s.handle("POST", "/permissions/remove_user_from_template", s.removeUserFromTemplate)
+	s.handle("POST", "/permissions/add_group_to_template", s.addGroupToTemplate)
+	s.handle("POST", "/permissions/remove_group_from_template", s.removeGroupFromTemplate)
+	s.handle("POST", "/permissions/add_project_creator_to_template", s.addProjectCreatorToTemplate)
+	s.handle("POST", "/permissions/remove_project_creator_from_template", s.removeProjectCreatorFromTemplate)
+	s.handle("POST", "/permissions/apply_template", s.applyTemplate)
+	s.handle("POST", "/permissions/bulk_apply_template", s.bulkApplyTemplate)
+}
+
+// scopeGrants returns the grants of the project that the request is about, or of the organization if it has no
+// project, along with the permissions that can be granted on it
+func (s *Server) scopeGrants(r request) (grants, []string, error) {
+	key := r.param("projectKey")
+	if key == "" {
+		return s.globalPermissions, globalPermissions, nil
+	}
+	p, err := s.findProject(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.permissions, projectPermissions, nil
+}
+
+// permission returns the permission parameter of the request, if it can be granted on the scope
+func permission(r request, allowed []string) (string, error) {
+	if err := r.required("permission"); err != nil {
+		return "", err
+	}
+	if err := r.oneOf("permission", allowed...); err != nil {
+		return "", err
+	}
+	return r.param("permission"), nil
+}
+
+// permissionGroup returns the group that is identified by the groupId or groupName parameter of the request
+func (s *Server) permissionGroup(r request) (grantee, error) {
+	if r.param("groupId") == "" && strings.EqualFold(r.param("groupName"), anyone) {
+		return groupGrantee(anyone), nil
+	}
+	g, err := s.findGroup(r, "groupId", "groupName")
+	if err != nil {
+		return grantee{}, err
+	}
+	return groupGrantee(g.name), nil
+}
+
+// removeGrantee revokes all permissions of the grantee, when it is removed from the organization
+func (s *Server) removeGrantee(who grantee) {
+	delete(s.globalPermissions, who)
+	for _, p := range s.projects {
+		delete(p.permissions, who)
+	}
+	for _, t := range s.templates {
+		delete(t.permissions, who)
+	}
+}
+
+// renameGrantee moves all permissions of the grantee to its new name
+func (s *Server) renameGrantee(from, to grantee) {
+	rename := func(g grants) {
+		if permissions, ok := g[from]; ok {
+			g[to] = permissions
+			delete(g, from)
+		}
+	}
+	rename(s.globalPermissions)
+	for _, p := range s.projects {
+		rename(p.permissions)
+	}
+	for _, t := range s.templates {
+		rename(t.permissions)
+	}
+}
+
+// userPermissions returns the members that match the q parameter with their permissions. Without a query, only the
+// members that have permissions are returned.
+func (s *Server) userPermissions(r request, g grants) []map[string]interface{} {
+	users := make([]map[string]interface{}, 0)
+	for _, login := range sortedKeys(s.members) {
+		u := s.users[login]
+		permissions := g.of(userGrantee(login))
+		if (r.param("q") == "" && len(permissions) == 0) || !matches(r.param("q"), u.login, u.name) {
+			continue
+		}
+		result := u.json()
+		result["id"] = u.login
+		result["permissions"] = permissions
+		users = append(users, result)
+	}
+	return users
+}
+
+// groupPermissions returns Anyone and the groups that match the q parameter with their permissions
+func (s *Server) groupPermissions(r request, g grants) []map[string]interface{} {
+	groups := make([]map[string]interface{}, 0)
+	if matches(r.param("q"), anyone) {
+		groups = append(groups, map[string]interface{}{
+			"name":        anyone,
+			"permissions": g.of(groupGrantee(anyone)),
+		})
+	}
+	for _, name := range sortedKeys(s.groups) {
+		if !matches(r.param("q"), name) {
+			continue
+		}
+		result := s.groups[name].json()
+		result["id"] = strconv.Itoa(s.groups[name].id)
+		result["permissions"] = g.of(groupGrantee(name))
+		groups = append(groups, result)
+	}
+	return groups
+}
+
+func (s *Server) permissionUsers(r request) (interface{}, error) {
+	g, _, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+
+	users, p := page(r, s.userPermissions(r, g))
+	return map[string]interface{}{"users": users, "paging": p}, nil
+}
+
+func (s *Server) permissionGroups(r request) (interface{}, error) {
+	g, _, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, p := page(r, s.groupPermissions(r, g))
+	return map[string]interface{}{"groups": groups, "paging": p}, nil
+}
+
+func (s *Server) addUserPermission(r request) (interface{}, error) {
+	g, allowed, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, allowed)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findMember(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	g.add(userGrantee(u.login), permission)
+	return nil, nil
+}
+
+func (s *Server) removeUserPermission(r request) (interface{}, error) {
+	g, allowed, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, allowed)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findUser(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	g.remove(userGrantee(u.login), permission)
+	return nil, nil
+}
+
+func (s *Server) addGroupPermission(r request) (interface{}, error) {
+	g, allowed, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, allowed)
+	if err != nil {
+		return nil, err
+	}
+	who, err := s.permissionGroup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g.add(who, permission)
+	return nil, nil
+}
+
+func (s *Server) removeGroupPermission(r request) (interface{}, error) {
+	g, allowed, err := s.scopeGrants(r)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, allowed)
+	if err != nil {
+		return nil, err
+	}
+	who, err := s.permissionGroup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g.remove(who, permission)
+	return nil, nil
+}
+
+// findTemplate returns the permission template that is identified by the id or name parameter of the request
+func (s *Server) findTemplate(r request, idParam, nameParam string) (*permissionTemplate, error) {
+	if id := r.param(idParam); id != "" {
+		t, ok := s.templates[id]
+		if !ok {
+			return nil, notFound("Permission template with id '%s' is not found", id)
+		}
+		return t, nil
+	}
+	if err := r.required(nameParam); err != nil {
+		return nil, badRequest("Template name or template id must be provided, not both.")
+	}
+	for _, t := range s.templates {
+		if strings.EqualFold(t.name, r.param(nameParam)) {
+			return t, nil
+		}
+	}
+	return nil, notFound("Permission template with name '%s' is not found (case insensitive) in organization with key '%s'", r.param(nameParam), Organization)
+}
+
+// templateNameTaken returns an error if another template than t has the name
+func (s *Server) templateNameTaken(name string, t *permissionTemplate) error {
+	for _, other := range s.templates {
+		if other != t && strings.EqualFold(other.name, name) {
+			return badRequest("A template with the name '%s' already exists (case insensitive).", name)
+		}
+	}
+	return nil
+}
+
+func (s *Server) searchTemplates(r request) (interface{}, error) {
+	templates := make([]map[string]interface{}, 0)
+	for _, id := range sortedKeys(s.templates) {
+		t := s.templates[id]
+		if !matches(r.param("q"), t.name) {
+			continue
+		}
+
+		permissions := make([]map[string]interface{}, 0)
+		for _, permission := range projectPermissions {
+			usersCount, groupsCount := 0, 0
+			for who, granted := range t.permissions {
+				if granted[permission] && who.group {
+					groupsCount++
+				} else if granted[permission] {
+					usersCount++
+				}
+			}
+			permissions = append(permissions, map[string]interface{}{
+				"key":                permission,
+				"usersCount":         usersCount,
+				"groupsCount":        groupsCount,
+				"withProjectCreator": t.projectCreator[permission],
+			})
+		}
+
+		result := t.json()
+		result["permissions"] = permissions
+		templates = append(templates, result)
+	}
+
+	return map[string]interface{}{
+		"permissionTemplates": templates,
+		"defaultTemplates": []map[string]interface{}{
+			{"templateId": s.defaultTemplate, "qualifier": "TRK"},
+		},
+	}, nil
+}
+
+func (s *Server) createTemplate(r request) (interface{}, error) {
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+	if err := s.templateNameTaken(r.param("name"), nil); err != nil {
+		return nil, err
+	}
+
+	t := &permissionTemplate{
+		id:                s.newKey("template"),
+		name:              r.param("name"),
+		description:       r.param("description"),
+		projectKeyPattern: r.param("projectKeyPattern"),
+		permissions:       newGrants(),
+		projectCreator:    make(map[string]bool),
+	}
+	s.templates[t.id] = t
+	return map[string]interface{}{"permissionTemplate": t.json()}, nil
+}
+
+func (s *Server) updateTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "id", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if r.has("name") {
+		if err := r.required("name"); err != nil {
+			return nil, err
+		}
+		if err := s.templateNameTaken(r.param("name"), t); err != nil {
+			return nil, err
+		}
+		t.name = r.param("name")
+	}
+	if r.has("description") {
+		t.description = r.param("description")
+	}
+	if r.has("projectKeyPattern") {
+		t.projectKeyPattern = r.param("projectKeyPattern")
+	}
+	return map[string]interface{}{"permissionTemplate": t.json()}, nil
+}
+
+func (s *Server) deleteTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	if t.id == s.defaultTemplate {
+		return nil, badRequest("It is not possible to delete the default permission template for projects")
+	}
+
+	delete(s.templates, t.id)
+	return nil, nil
+}
+
+func (s *Server) setDefaultTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("qualifier", "TRK"); err != nil {
+		return nil, err
+	}
+
+	s.defaultTemplate = t.id
+	return nil, nil
+}
+
+func (s *Server) templateUsers(r request) (interface{}, error) {
+	if err := r.minLength("q", 3); err != nil {
+		return nil, err
+	}
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+
+	users, p := page(r, s.userPermissions(r, t.permissions))
+	return map[string]interface{}{"users": users, "paging": p}, nil
+}
+
+func (s *Server) templateGroups(r request) (interface{}, error) {
+	if err := r.minLength("q", 3); err != nil {
+		return nil, err
+	}
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []map[string]interface{}
+	for _, g := range s.groupPermissions(r, t.permissions) {
+		// Unlike for projects, only the groups with permissions are returned for templates without a query
+		if r.param("q") != "" || len(g["permissions"].([]string)) > 0 {
+			groups = append(groups, g)
+		}
+	}
+
+	groups, p := page(r, groups)
+	return map[string]interface{}{"groups": groups, "paging": p}, nil
+}
+
+func (s *Server) addUserToTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+	u, err := s.findMember(r.param("login"))
+	if err != nil {
+		return nil, err
+	}
+
+	t.permissions.add(userGrantee(u.login), permission)
+	return nil, nil
+}
+
+func (s *Server) removeUserFromTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("login"); err != nil {
+		return nil, err
+	}
+
+	t.permissions.remove(userGrantee(r.param("login")), permission)
+	return nil, nil
+}
+
+func (s *Server) addGroupToTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+	who, err := s.permissionGroup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t.permissions.add(who, permission)
+	return nil, nil
+}
+
+func (s *Server) removeGroupFromTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+	who, err := s.permissionGroup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t.permissions.remove(who, permission)
+	return nil, nil
+}
+
+func (s *Server) addProjectCreatorToTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	t.projectCreator[permission] = true
+	return nil, nil
+}
+
+func (s *Server) removeProjectCreatorFromTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	permission, err := permission(r, projectPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(t.projectCreator, permission)
+	return nil, nil
+}
+
+func (s *Server) applyTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("projectKey"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.permissions = t.permissions.copy()
+	return nil, nil
+}
+
+func (s *Server) bulkApplyTemplate(r request) (interface{}, error) {
+	t, err := s.findTemplate(r, "templateId", "templateName")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range s.searchedProjects(r) {
+		p.permissions = t.permissions.copy()
+	}
+	return nil, nil
+}
@@ -0,0 +1,431 @@
+package fakeapi
+
+import (
+	"regexp"
+	"strings"
+)
+
+// project is a project of the organization
+type project struct {
+	key        string
+	name       string
+	visibility string
+	tags       []string
+	autoscan   bool
+	mainBranch string
+	links      []*link
+	gate       int
+	// permissions are the permissions on the project, which are initialised from the default permission template
+	permissions grants
+	// newCodePeriods are the new code periods by branch, where the empty branch is the project itself
+	newCodePeriods map[string]newCodePeriod
+	// analyzedAt is the date of the last analysis, which is empty for projects that have never been analyzed
+	analyzedAt string
+}
+
+func (p *project) json() map[string]interface{} {
+	result := map[string]interface{}{
+		"key":          p.key,
+		"name":         p.name,
+		"organization": Organization,
+		"qualifier":    "TRK",
+		"visibility":   p.visibility,
+	}
+	if p.analyzedAt != "" {
+		result["lastAnalysisDate"] = p.analyzedAt
+	}
+	return result
+}
+
+// link is a link of a project
+type link struct {
+	id       string
+	name     string
+	url      string
+	linkType string
+}
+
+func (l *link) json() map[string]interface{} {
+	return map[string]interface{}{
+		"id":   l.id,
+		"name": l.name,
+		"type": l.linkType,
+		"url":  l.url,
+	}
+}
+
+// projectKeyPattern is the pattern that project keys must match
+var projectKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_\-.:]*[a-zA-Z_\-.:]+[a-zA-Z0-9_\-.:]*$`)
+
+func (s *Server) addProjectRoutes() {
+	s.handle("POST", "/projects/create", s.createProject)
+	s.handle("POST", "/projects/delete", s.deleteProject)
+	s.handle("GET", "/projects/search", s.searchProjects)
+	s.handle("POST", "/projects/update_key", s.updateProjectKey)
+	s.handle("POST", "/projects/update_visibility", s.updateProjectVisibility)
+
+	s.handle("GET", "/components/show", s.showComponent)
+	s.handle("GET", "/components/search_projects", s.searchComponentProjects)
+	s.handle("POST", "/project_tags/set", s.setProjectTags)
+
+	s.handle("POST", "/autoscan/activation", s.activateAutoscan)
+	s.handle("GET", "/autoscan/eligibility", s.autoscanEligibility)
+
+	s.handle("GET", "/project_branches/list", s.listBranches)
+	s.handle("POST", "/project_branches/rename", s.renameBranch)
+
+	s.handle("POST", "/project_links/create", s.createLink)
+	s.handle("POST", "/project_links/delete", s.deleteLink)
+	s.handle("GET", "/project_links/search", s.searchLinks)
+}
+
+// addProject adds a project with the permissions of the default permission template
+func (s *Server) addProject(key, name, visibility string) *project {
+	p := &project{
+		key:         key,
+		name:        name,
+		visibility:  visibility,
+		mainBranch:  "main",
+		permissions: s.templates[s.defaultTemplate].permissions.copy(),
+	}
+	s.projects[key] = p
+	return p
+}
+
+// findProject returns the project with the key
+func (s *Server) findProject(key string) (*project, error) {
+	p, ok := s.projects[key]
+	if !ok {
+		return nil, notFound("Project '%s' not found", key)
+	}
+	return p, nil
+}
+
+// searchedProjects returns the projects that match the q, projects, analyzedBefore and onProvisionedOnly parameters
+func (s *Server) searchedProjects(r request) []*project {
+	keys := make(map[string]bool)
+	for _, key := range r.list("projects") {
+		keys[key] = true
+	}
+
+	var result []*project
+	for _, key := range sortedKeys(s.projects) {
+		p := s.projects[key]
+		if len(keys) > 0 && !keys[key] {
+			continue
+		}
+		if !matches(r.param("q"), p.key, p.name) {
+			continue
+		}
+		if r.param("analyzedBefore") != "" && (p.analyzedAt == "" || p.analyzedAt >= r.param("analyzedBefore")) {
+			continue
+		}
+		if r.param("onProvisionedOnly") == "true" && p.analyzedAt != "" {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+func (s *Server) createProject(r request) (interface{}, error) {
+	if err := r.required("project", "name"); err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("visibility", "public", "private"); err != nil {
+		return nil, err
+	}
+	key := r.param("project")
+	if !projectKeyPattern.MatchString(key) {
+		return nil, badRequest("Malformed key for Project: '%s'. Allowed characters are alphanumeric, '-', '_', '.' and ':', with at least one non-digit.", key)
+	}
+	if _, ok := s.projects[key]; ok {
+		return nil, badRequest("Could not create Project, key already exists: %s", key)
+	}
+	visibility := r.param("visibility")
+	if visibility == "" {
+		visibility = "public"
+	}
+
+	p := s.addProject(key, r.param("name"), visibility)
+	return map[string]interface{}{"project": p.json()}, nil
+}
+
+func (s *Server) deleteProject(r request) (interface{}, error) {
+	if err := r.required("project"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.projects, p.key)
+	for key, w := range s.webhooks {
+		if w.project == p.key {
+			delete(s.webhooks, key)
+		}
+	}
+	for key, setting := range s.settings {
+		if setting.component == p.key {
+			delete(s.settings, key)
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) searchProjects(r request) (interface{}, error) {
+	var components []map[string]interface{}
+	for _, p := range s.searchedProjects(r) {
+		components = append(components, p.json())
+	}
+
+	components, paging := page(r, components)
+	return map[string]interface{}{"components": components, "paging": paging}, nil
+}
+
+func (s *Server) updateProjectKey(r request) (interface{}, error) {
+	if err := r.required("from", "to"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("from"))
+	if err != nil {
+		return nil, err
+	}
+	to := r.param("to")
+	if !projectKeyPattern.MatchString(to) {
+		return nil, badRequest("Malformed key for Project: '%s'. Allowed characters are alphanumeric, '-', '_', '.' and ':', with at least one non-digit.", to)
+	}
+	if _, ok := s.projects[to]; ok {
+		return nil, badRequest("Impossible to update key: a component with key \"%s\" already exists.", to)
+	}
+
+	delete(s.projects, p.key)
+	for _, w := range s.webhooks {
+		if w.project == p.key {
+			w.project = to
+		}
+	}
+	for key, setting := range s.settings {
+		if setting.component == p.key {
+			delete(s.settings, key)
+			setting.component = to
+			s.settings[settingID(to, setting.key)] = setting
+		}
+	}
+	p.key = to
+	s.projects[to] = p
+	return nil, nil
+}
+
+func (s *Server) updateProjectVisibility(r request) (interface{}, error) {
+	if err := r.required("project", "visibility"); err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("visibility", "public", "private"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.visibility = r.param("visibility")
+	return nil, nil
+}
+
+// componentTags returns the tags of the project, which are never null
+func componentTags(p *project) []string {
+	if p.tags == nil {
+		return []string{}
+	}
+	return p.tags
+}
+
+func (s *Server) showComponent(r request) (interface{}, error) {
+	if err := r.required("component"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("component"))
+	if err != nil {
+		return nil, notFound("Component key '%s' not found", r.param("component"))
+	}
+
+	component := p.json()
+	component["tags"] = componentTags(p)
+	return map[string]interface{}{"component": component}, nil
+}
+
+// tagsFilterPattern matches the only filter of components/search_projects that the fake supports, e.g. "tags in (a, b)"
+var tagsFilterPattern = regexp.MustCompile(`^tags\s+in\s+\((.*)\)$`)
+
+func (s *Server) searchComponentProjects(r request) (interface{}, error) {
+	var tags map[string]bool
+	if filter := strings.TrimSpace(r.param("filter")); filter != "" {
+		match := tagsFilterPattern.FindStringSubmatch(filter)
+		if match == nil {
+			return nil, badRequest("Unsupported filter: %s", filter)
+		}
+		tags = make(map[string]bool)
+		for _, tag := range strings.Split(match[1], ",") {
+			tags[strings.TrimSpace(tag)] = true
+		}
+	}
+
+	var components []map[string]interface{}
+	for _, key := range sortedKeys(s.projects) {
+		p := s.projects[key]
+		if tags != nil && !hasAnyTag(p, tags) {
+			continue
+		}
+		component := p.json()
+		component["tags"] = componentTags(p)
+		components = append(components, component)
+	}
+
+	components, paging := page(r, components)
+	return map[string]interface{}{"components": components, "paging": paging}, nil
+}
+
+// hasAnyTag reports whether the project has any of the tags
+func hasAnyTag(p *project, tags map[string]bool) bool {
+	for _, tag := range p.tags {
+		if tags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) setProjectTags(r request) (interface{}, error) {
+	if err := r.required("project"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+
+	unique := make(map[string]bool)
+	for _, tag := range r.list("tags") {
+		if tag != "" {
+			unique[strings.ToLower(tag)] = true
+		}
+	}
+	p.tags = sortedKeys(unique)
+	return nil, nil
+}
+
+func (s *Server) activateAutoscan(r request) (interface{}, error) {
+	if err := r.required("projectKey", "enable"); err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("enable", "true", "false"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.autoscan = r.param("enable") == "true"
+	return nil, nil
+}
+
+func (s *Server) autoscanEligibility(r request) (interface{}, error) {
+	if err := r.required("projectKey"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"eligible": true, "autoscanEnabled": p.autoscan}, nil
+}
+
+func (s *Server) listBranches(r request) (interface{}, error) {
+	if err := r.required("project"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"branches": []map[string]interface{}{
+			{"name": p.mainBranch, "isMain": true, "type": "LONG"},
+		},
+	}, nil
+}
+
+func (s *Server) renameBranch(r request) (interface{}, error) {
+	if err := r.required("project", "name"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mainBranch = r.param("name")
+	return nil, nil
+}
+
+// findLink returns the project and link with the id
+func (s *Server) findLink(id string) (*project, int, error) {
+	for _, p := range s.projects {
+		for i, l := range p.links {
+			if l.id == id {
+				return p, i, nil
+			}
+		}
+	}
+	return nil, 0, notFound("Link with id '%s' not found", id)
+}
+
+func (s *Server) createLink(r request) (interface{}, error) {
+	if err := r.required("projectKey", "name", "url"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	l := &link{id: s.newKey("link"), name: r.param("name"), url: r.param("url")}
+	p.links = append(p.links, l)
+	return map[string]interface{}{
+		"link": map[string]interface{}{"id": l.id, "name": l.name, "url": l.url},
+	}, nil
+}
+
+func (s *Server) deleteLink(r request) (interface{}, error) {
+	if err := r.required("id"); err != nil {
+		return nil, err
+	}
+	p, i, err := s.findLink(r.param("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.links = append(p.links[:i], p.links[i+1:]...)
+	return nil, nil
+}
+
+func (s *Server) searchLinks(r request) (interface{}, error) {
+	if err := r.required("projectKey"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]map[string]interface{}, 0, len(p.links))
+	for _, l := range p.links {
+		links = append(links, l.json())
+	}
+	return map[string]interface{}{"links": links}, nil
+}
@@ -0,0 +1,333 @@
+package fakeapi
+
+import (
+	"sort"
+	"strconv"
+)
+
+// qualityGate is a quality gate of the organization
+type qualityGate struct {
+	id         int
+	name       string
+	isBuiltIn  bool
+	conditions []*condition
+}
+
+// condition is a condition of a quality gate
+type condition struct {
+	id     int
+	metric string
+	op     string
+	error  string
+}
+
+func (c *condition) json() map[string]interface{} {
+	return map[string]interface{}{
+		"id":     c.id,
+		"metric": c.metric,
+		"op":     c.op,
+		"error":  c.error,
+	}
+}
+
+func (s *Server) addQualityGateRoutes() {
+	s.handle("GET", "/qualitygates/list", s.listQualityGates)
+	s.handle("POST", "/qualitygates/create", s.createQualityGate)
+	s.handle("POST", "/qualitygates/destroy", s.destroyQualityGate)
+	s.handle("POST", "/qualitygates/rename", s.renameQualityGate)
+	s.handle("POST", "/qualitygates/set_as_default", s.setDefaultQualityGate)
+	s.handle("POST", "/qualitygates/create_condition", s.createCondition)
+	s.handle("POST", "/qualitygates/update_condition", s.updateCondition)
+	s.handle("POST", "/qualitygates/delete_condition", s.deleteCondition)
+	s.handle("POST", "/qualitygates/select", s.selectQualityGate)
+	s.handle("POST", "/qualitygates/deselect", s.deselectQualityGate)
+	s.handle("GET", "/qualitygates/search", s.searchQualityGateProjects)
+}
+
+// findQualityGate returns the quality gate with the id in the parameter
+func (s *Server) findQualityGate(r request, idParam string) (*qualityGate, error) {
+	if err := r.required(idParam); err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(r.param(idParam))
+	if err != nil {
+		return nil, badRequest("The '%s' parameter cannot be parsed as an integer value: %s", idParam, r.param(idParam))
+	}
+	g, ok := s.qualityGates[id]
+	if !ok {
+		return nil, notFound("No quality gate has been found for id %d in organization %s", id, Organization)
+	}
+	return g, nil
+}
+
+// findCondition returns the quality gate and condition with the id in the parameter
+func (s *Server) findCondition(r request) (*qualityGate, int, error) {
+	if err := r.required("id"); err != nil {
+		return nil, 0, err
+	}
+	for _, g := range s.qualityGates {
+		for i, c := range g.conditions {
+			if strconv.Itoa(c.id) == r.param("id") {
+				return g, i, nil
+			}
+		}
+	}
+	return nil, 0, notFound("No quality gate condition with id '%s'", r.param("id"))
+}
+
+// qualityGateNameTaken returns an error if another quality gate than g has the name
+func (s *Server) qualityGateNameTaken(name string, g *qualityGate) error {
+	for _, other := range s.qualityGates {
+		if other != g && other.name == name {
+			return badRequest("Name has already been taken")
+		}
+	}
+	return nil
+}
+
+// modifiable returns an error if the conditions or name of the quality gate cannot be changed
+func modifiable(g *qualityGate) error {
+	if g.isBuiltIn {
+		return badRequest("Operation forbidden for built-in Quality Gate '%s'", g.name)
+	}
+	return nil
+}
+
+// gateOf returns the ID of the quality gate of the project, which is the default quality gate if none is selected
+func (s *Server) gateOf(p *project) int {
+	if p.gate == 0 {
+		return s.defaultQualityGate
+	}
+	return p.gate
+}
+
+func (s *Server) listQualityGates(_ request) (interface{}, error) {
+	ids := make([]int, 0, len(s.qualityGates))
+	for id := range s.qualityGates {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	gates := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		g := s.qualityGates[id]
+		conditions := make([]map[string]interface{}, 0, len(g.conditions))
+		for _, c := range g.conditions {
+			conditions = append(conditions, c.json())
+		}
+		gates = append(gates, map[string]interface{}{
+			"id":         g.id,
+			"name":       g.name,
+			"isBuiltIn":  g.isBuiltIn,
+			"isDefault":  g.id == s.defaultQualityGate,
+			"conditions": conditions,
+			"actions": map[string]interface{}{
+				"rename":            !g.isBuiltIn,
+				"setAsDefault":      g.id != s.defaultQualityGate,
+				"copy":              true,
+				"associateProjects": true,
+				"delete":            !g.isBuiltIn && g.id != s.defaultQualityGate,
+				"manageConditions":  !g.isBuiltIn,
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"qualitygates": gates,
+		"default":      s.defaultQualityGate,
+		"actions":      map[string]interface{}{"create": true},
+	}, nil
+}
+
+func (s *Server) createQualityGate(r request) (interface{}, error) {
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+	if err := s.qualityGateNameTaken(r.param("name"), nil); err != nil {
+		return nil, err
+	}
+
+	g := &qualityGate{id: s.newID(), name: r.param("name")}
+	s.qualityGates[g.id] = g
+	return map[string]interface{}{"id": g.id, "name": g.name}, nil
+}
+
+func (s *Server) destroyQualityGate(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	if err := modifiable(g); err != nil {
+		return nil, err
+	}
+	if g.id == s.defaultQualityGate {
+		return nil, badRequest("The default quality gate cannot be removed")
+	}
+
+	delete(s.qualityGates, g.id)
+	for _, p := range s.projects {
+		if p.gate == g.id {
+			p.gate = 0
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) renameQualityGate(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("name"); err != nil {
+		return nil, err
+	}
+	if err := modifiable(g); err != nil {
+		return nil, err
+	}
+	if err := s.qualityGateNameTaken(r.param("name"), g); err != nil {
+		return nil, err
+	}
+
+	g.name = r.param("name")
+	return map[string]interface{}{"id": g.id, "name": g.name}, nil
+}
+
+func (s *Server) setDefaultQualityGate(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	s.defaultQualityGate = g.id
+	return nil, nil
+}
+
+// conditionParams returns an error if the metric, op or error parameters of a condition are missing or invalid
+func conditionParams(r request) error {
+	if err := r.required("metric", "error"); err != nil {
+		return err
+	}
+	return r.oneOf("op", "LT", "GT")
+}
+
+func (s *Server) createCondition(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	if err := conditionParams(r); err != nil {
+		return nil, err
+	}
+	if err := modifiable(g); err != nil {
+		return nil, err
+	}
+	for _, c := range g.conditions {
+		if c.metric == r.param("metric") {
+			return nil, badRequest("Condition on metric '%s' already exists.", c.metric)
+		}
+	}
+
+	c := &condition{id: s.newID(), metric: r.param("metric"), op: r.param("op"), error: r.param("error")}
+	if c.op == "" {
+		c.op = "GT"
+	}
+	g.conditions = append(g.conditions, c)
+	return c.json(), nil
+}
+
+func (s *Server) updateCondition(r request) (interface{}, error) {
+	g, i, err := s.findCondition(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := conditionParams(r); err != nil {
+		return nil, err
+	}
+	if err := modifiable(g); err != nil {
+		return nil, err
+	}
+
+	c := g.conditions[i]
+	c.metric = r.param("metric")
+	c.error = r.param("error")
+	if r.param("op") != "" {
+		c.op = r.param("op")
+	}
+	return c.json(), nil
+}
+
+func (s *Server) deleteCondition(r request) (interface{}, error) {
+	g, i, err := s.findCondition(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := modifiable(g); err != nil {
+		return nil, err
+	}
+
+	g.conditions = append(g.conditions[:i], g.conditions[i+1:]...)
+	return nil, nil
+}
+
+func (s *Server) selectQualityGate(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("projectKey"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.gate = g.id
+	return nil, nil
+}
+
+func (s *Server) deselectQualityGate(r request) (interface{}, error) {
+	if err := r.required("projectKey"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("projectKey"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.gate = 0
+	return nil, nil
+}
+
+func (s *Server) searchQualityGateProjects(r request) (interface{}, error) {
+	g, err := s.findQualityGate(r, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("selected", "selected", "deselected", "all"); err != nil {
+		return nil, err
+	}
+	selected := r.param("selected")
+	if selected == "" {
+		selected = "selected"
+	}
+	if r.param("query") != "" {
+		selected = "all"
+	}
+
+	var results []map[string]interface{}
+	for _, key := range sortedKeys(s.projects) {
+		p := s.projects[key]
+		isSelected := s.gateOf(p) == g.id
+		if (selected == "selected" && !isSelected) || (selected == "deselected" && isSelected) {
+			continue
+		}
+		if !matches(r.param("query"), p.key, p.name) {
+			continue
+		}
+		results = append(results, map[string]interface{}{"key": p.key, "name": p.name, "selected": isSelected})
+	}
+
+	results, paging := pageWith(r, "page", "pageSize", results)
+	return map[string]interface{}{"results": results, "paging": paging}, nil
+}
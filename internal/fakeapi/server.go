@@ -0,0 +1,387 @@
+// Package fakeapi implements a stateful fake of the parts of the SonarCloud API that the provider uses, so that the
+// acceptance tests can run without network access or a SonarCloud organization.
+//
+// The fake keeps its state in memory and mimics the responses and error messages of SonarCloud closely enough for the
+// provider, but it does not validate everything SonarCloud does. Each server starts with a fixed set of fixtures, see
+// Env for the values the acceptance tests use.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The fixtures that every server starts with
+const (
+	Organization           = "fake-organization"
+	Token                  = "fake-token"
+	UserLogin              = "fake-user@github"
+	TokenUserLogin         = "fake-token-user@github"
+	NewMemberLogin         = "fake-new-member@github"
+	GroupName              = "TEST_DONT_REMOVE"
+	ProjectKey             = "fake-project"
+	QualityGateName        = "TEST"
+	PermissionTemplateName = "Default template"
+)
+
+// handler handles a request to an endpoint. It returns the response that is sent as JSON, or nil for no content.
+type handler func(r request) (interface{}, error)
+
+// Server is a fake SonarCloud API that is served over HTTP on a local port
+type Server struct {
+	// URL is the base URL of the API, which is passed to the provider as api_url
+	URL string
+
+	server *httptest.Server
+	routes map[string]handler
+
+	mu     sync.Mutex
+	nextID int
+
+	users   map[string]*user
+	members map[string]bool
+	tokens  map[string]map[string]string
+
+	groups            map[string]*group
+	globalPermissions grants
+
+	templates       map[string]*permissionTemplate
+	defaultTemplate string
+
+	projects map[string]*project
+
+	qualityGates       map[int]*qualityGate
+	defaultQualityGate int
+
+	webhooks map[string]*webhook
+
+	settings      map[string]setting
+	newCodePeriod newCodePeriod
+}
+
+// NewServer starts a new fake API with the fixtures. It must be closed when done.
+func NewServer() *Server {
+	s := &Server{
+		nextID:       100,
+		users:        make(map[string]*user),
+		members:      make(map[string]bool),
+		tokens:       make(map[string]map[string]string),
+		groups:       make(map[string]*group),
+		templates:    make(map[string]*permissionTemplate),
+		projects:     make(map[string]*project),
+		qualityGates: make(map[int]*qualityGate),
+		webhooks:     make(map[string]*webhook),
+		settings:     make(map[string]setting),
+	}
+	s.routes = make(map[string]handler)
+	s.addOrganizationRoutes()
+	s.addProjectRoutes()
+	s.addQualityGateRoutes()
+	s.addPermissionRoutes()
+	s.addWebhookRoutes()
+	s.addSettingRoutes()
+	s.addFixtures()
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + "/api"
+	return s
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Env returns the environment variables that configure the provider and the acceptance tests for the fixtures
+func (s *Server) Env() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	env := map[string]string{
+		"SONARCLOUD_API_URL":                s.URL,
+		"SONARCLOUD_ORGANIZATION":           Organization,
+		"SONARCLOUD_TOKEN":                  Token,
+		"SONARCLOUD_TEST_USER_LOGIN":        UserLogin,
+		"SONARCLOUD_TEST_GROUP_NAME":        GroupName,
+		"SONARCLOUD_TOKEN_TEST_USER_LOGIN":  TokenUserLogin,
+		"SONARCLOUD_TEST_NEW_MEMBER_LOGIN":  NewMemberLogin,
+		"SONARCLOUD_PROJECT_KEY":            ProjectKey,
+		"SONARCLOUD_QUALITY_GATE_NAME":      QualityGateName,
+		"SONARCLOUD_PERMISSION_TEMPLATE_ID": s.defaultTemplate,
+	}
+	for id, gate := range s.qualityGates {
+		if gate.name == QualityGateName {
+			env["SONARCLOUD_QUALITY_GATE_ID"] = strconv.Itoa(id)
+		}
+	}
+	return env
+}
+
+// addFixtures adds the users, groups, project, quality gates and permission template that every server starts with
+func (s *Server) addFixtures() {
+	s.users[UserLogin] = &user{login: UserLogin, name: "Fake User"}
+	s.users[TokenUserLogin] = &user{login: TokenUserLogin, name: "Fake Token User"}
+	s.users[NewMemberLogin] = &user{login: NewMemberLogin, name: "Fake New Member"}
+	s.members[UserLogin] = true
+	s.members[TokenUserLogin] = true
+
+	s.groups["Members"] = &group{id: s.newID(), name: "Members", description: "All members of the organization",
+		isDefault: true, members: map[string]bool{UserLogin: true, TokenUserLogin: true}}
+	s.groups["Owners"] = &group{id: s.newID(), name: "Owners", description: "Owners of the organization",
+		members: map[string]bool{TokenUserLogin: true}}
+	s.groups[GroupName] = &group{id: s.newID(), name: GroupName, members: map[string]bool{}}
+
+	s.globalPermissions = newGrants()
+	for _, permission := range globalPermissions {
+		s.globalPermissions.add(groupGrantee("Owners"), permission)
+	}
+	s.globalPermissions.add(groupGrantee("Members"), "scan")
+	s.globalPermissions.add(groupGrantee("Members"), "provisioning")
+
+	template := &permissionTemplate{id: s.newKey("template"), name: PermissionTemplateName,
+		description: "This permission template will be used as default when no other permission configuration is available",
+		permissions: newGrants()}
+	for _, permission := range projectPermissions {
+		template.permissions.add(groupGrantee("Owners"), permission)
+	}
+	template.permissions.add(groupGrantee("Members"), "codeviewer")
+	template.permissions.add(groupGrantee("Members"), "user")
+	s.templates[template.id] = template
+	s.defaultTemplate = template.id
+
+	builtIn := &qualityGate{id: 9, name: "Sonar way", isBuiltIn: true, conditions: []*condition{
+		{id: s.newID(), metric: "new_coverage", op: "LT", error: "80"},
+		{id: s.newID(), metric: "new_duplicated_lines_density", op: "GT", error: "3"},
+	}}
+	s.qualityGates[builtIn.id] = builtIn
+	s.defaultQualityGate = builtIn.id
+	gate := &qualityGate{id: s.newID(), name: QualityGateName, conditions: []*condition{
+		{id: s.newID(), metric: "coverage", op: "LT", error: "50"},
+	}}
+	s.qualityGates[gate.id] = gate
+
+	s.addProject(ProjectKey, "Fake Project", "public")
+
+	s.newCodePeriod = newCodePeriod{periodType: "PREVIOUS_VERSION"}
+}
+
+// newID returns a new unique numeric ID
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// newKey returns a new unique key with the given prefix
+func (s *Server) newKey(prefix string) string {
+	return fmt.Sprintf("%s-%d", prefix, s.newID())
+}
+
+// handle registers the handler for the endpoint, e.g. handle("POST", "/projects/create", ...)
+func (s *Server) handle(method, path string, h handler) {
+	s.routes[method+" /api"+path] = h
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if token, _, ok := r.BasicAuth(); !ok || token != Token {
+		writeError(w, &apiError{status: http.StatusUnauthorized, message: "Authentication is required"})
+		return
+	}
+
+	h, ok := s.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		writeError(w, notFound("Unknown url : %s", r.URL.Path))
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, badRequest("Could not parse the parameters: %s", err))
+		return
+	}
+	req := request{r}
+	// Searching organizations is not scoped to one, so the organization that the client always sends is ignored there
+	organization := req.param("organization")
+	if organization != "" && organization != Organization && r.URL.Path != "/api/organizations/search" {
+		writeError(w, notFound("No organization with key '%s'", organization))
+		return
+	}
+
+	s.mu.Lock()
+	response, err := h(req)
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if response == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// apiError is an error response in the format of the API
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &apiError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &apiError{status: http.StatusNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"msg": e.message}},
+	})
+}
+
+// request is a request to the API of which the query and form parameters have been parsed
+type request struct {
+	*http.Request
+}
+
+// param returns the value of the query or form parameter
+func (r request) param(name string) string {
+	return r.Form.Get(name)
+}
+
+// params returns all values of the query or form parameter
+func (r request) params(name string) []string {
+	return r.Form[name]
+}
+
+// has reports whether the parameter was sent, even if it is empty
+func (r request) has(name string) bool {
+	_, ok := r.Form[name]
+	return ok
+}
+
+// list returns the values of a comma separated parameter
+func (r request) list(name string) []string {
+	value := r.param(name)
+	if value == "" {
+		return nil
+	}
+	values := strings.Split(value, ",")
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+// required returns an error for the first of the parameters that is missing
+func (r request) required(names ...string) error {
+	for _, name := range names {
+		if r.param(name) == "" {
+			return badRequest("The '%s' parameter is missing", name)
+		}
+	}
+	return nil
+}
+
+// oneOf returns an error if the value of the parameter is set, but not one of the allowed values
+func (r request) oneOf(name string, allowed ...string) error {
+	value := r.param(name)
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return badRequest("Value of parameter '%s' (%s) must be one of: [%s]", name, value, strings.Join(allowed, ", "))
+}
+
+// minLength returns an error if the value of the parameter is set, but shorter than min
+func (r request) minLength(name string, min int) error {
+	if value := r.param(name); value != "" && len(value) < min {
+		return badRequest("'%s' length (%d) is shorter than the minimum authorized (%d)", name, len(value), min)
+	}
+	return nil
+}
+
+// paging is the paging of a response in the format of the API
+type paging struct {
+	PageIndex int `json:"pageIndex"`
+	PageSize  int `json:"pageSize"`
+	Total     int `json:"total"`
+}
+
+// page returns the page of the items that was requested with the p and ps parameters
+func page[T any](r request, items []T) ([]T, paging) {
+	return pageWith(r, "p", "ps", items)
+}
+
+// pageWith returns the page of the items that was requested with the given page and page size parameters
+func pageWith[T any](r request, pageParam, sizeParam string, items []T) ([]T, paging) {
+	index, err := strconv.Atoi(r.param(pageParam))
+	if err != nil || index < 1 {
+		index = 1
+	}
+	size, err := strconv.Atoi(r.param(sizeParam))
+	if err != nil || size < 1 {
+		size = 100
+	}
+
+	start := (index - 1) * size
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end], paging{PageIndex: index, PageSize: size, Total: len(items)}
+}
+
+// sortedKeys returns the keys of the map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// matches reports whether any of the values contains the query, ignoring case. An empty query matches everything.
+func matches(query string, values ...string) bool {
+	if query == "" {
+		return true
+	}
+	query = strings.ToLower(query)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// now returns the current time in the format of the API
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05-0700")
+}
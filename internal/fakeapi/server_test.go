@@ -0,0 +1,262 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_groups"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/webhooks"
+)
+
+// apiTransport sends the requests of the client, which always targets SonarCloud, to the fake
+type apiTransport struct {
+	url string
+}
+
+func (t apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	target, err := http.NewRequest(req.Method, t.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = target.URL.Scheme
+	req.URL.Host = target.URL.Host
+	req.Host = target.URL.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestClient(t *testing.T, organization, token string) (*Server, *sonarcloud.Client) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	httpClient := &http.Client{Transport: apiTransport{url: server.URL}}
+	return server, sonarcloud.NewClient(organization, token, httpClient)
+}
+
+func statusCode(err error) int {
+	var errorResponse *sonarcloud.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return errorResponse.StatusCode
+	}
+	return 0
+}
+
+func TestServerAuthentication(t *testing.T) {
+	_, client := newTestClient(t, Organization, "wrong-token")
+
+	_, err := client.Qualitygates.List(qualitygates.ListRequest{})
+	if statusCode(err) != http.StatusUnauthorized {
+		t.Errorf("expected a request with a wrong token to be unauthorized, got: %+v", err)
+	}
+}
+
+func TestServerOrganization(t *testing.T) {
+	_, client := newTestClient(t, "other-organization", Token)
+
+	_, err := client.Qualitygates.List(qualitygates.ListRequest{})
+	if statusCode(err) != http.StatusNotFound {
+		t.Errorf("expected a request for another organization to be not found, got: %+v", err)
+	}
+
+	for organization, want := range map[string]int{Organization: 1, "other-organization": 0} {
+		req, err := client.GetRequest(sonarcloud.API+"/organizations/search", "organizations", organization)
+		if err != nil {
+			t.Fatalf("could not create request: %+v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("could not search organizations: %+v", err)
+		}
+		var response struct {
+			Organizations []struct {
+				Key string `json:"key"`
+			} `json:"organizations"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("could not decode response: %+v", err)
+		}
+		if len(response.Organizations) != want {
+			t.Errorf("expected %d organizations for %q, got: %+v", want, organization, response.Organizations)
+		}
+	}
+}
+
+func TestServerProjects(t *testing.T) {
+	_, client := newTestClient(t, Organization, Token)
+
+	created, err := client.Projects.Create(projects.CreateRequest{Name: "Test", Project: "test-project", Visibility: "private"})
+	if err != nil {
+		t.Fatalf("could not create project: %+v", err)
+	}
+	if created.Project.Key != "test-project" {
+		t.Errorf("expected the created project to have key %q, got: %q", "test-project", created.Project.Key)
+	}
+
+	_, err = client.Projects.Create(projects.CreateRequest{Name: "Test", Project: "test-project"})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected creating a duplicate project to be a bad request, got: %+v", err)
+	}
+
+	found, err := client.Projects.SearchAll(projects.SearchRequest{Projects: "test-project"})
+	if err != nil {
+		t.Fatalf("could not search projects: %+v", err)
+	}
+	if len(found.Components) != 1 || found.Components[0].Visibility != "private" {
+		t.Errorf("expected to find the private project, got: %+v", found.Components)
+	}
+
+	if err := client.Projects.Delete(projects.DeleteRequest{Project: "test-project"}); err != nil {
+		t.Fatalf("could not delete project: %+v", err)
+	}
+	err = client.Projects.Delete(projects.DeleteRequest{Project: "test-project"})
+	if statusCode(err) != http.StatusNotFound {
+		t.Errorf("expected deleting a deleted project to be not found, got: %+v", err)
+	}
+}
+
+type permissionsRequest struct {
+	ProjectKey string
+}
+
+type templateGroupsRequest struct {
+	TemplateId string
+	Q          string
+}
+
+type permissionsResponseItem struct {
+	Login       string   `json:"login"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+func TestServerPermissions(t *testing.T) {
+	server, client := newTestClient(t, Organization, Token)
+
+	request := permissions.AddUserRequest{Login: UserLogin, Permission: "issueadmin", ProjectKey: ProjectKey}
+	if err := client.Permissions.AddUser(request); err != nil {
+		t.Fatalf("could not add user permission: %+v", err)
+	}
+
+	users, err := sonarcloud.GetAll[permissionsRequest, permissionsResponseItem](client, "/permissions/users", permissionsRequest{ProjectKey: ProjectKey}, "users")
+	if err != nil {
+		t.Fatalf("could not list user permissions: %+v", err)
+	}
+	if len(users) != 1 || users[0].Login != UserLogin {
+		t.Fatalf("expected only %q to have permissions on the project, got: %+v", UserLogin, users)
+	}
+	if got := users[0].Permissions; len(got) != 1 || got[0] != "issueadmin" {
+		t.Errorf("expected the user to have the issueadmin permission, got: %+v", got)
+	}
+
+	groups, err := sonarcloud.GetAll[permissionsRequest, permissionsResponseItem](client, "/permissions/groups", permissionsRequest{ProjectKey: ProjectKey}, "groups")
+	if err != nil {
+		t.Fatalf("could not list group permissions: %+v", err)
+	}
+	if len(groups) != 4 || groups[0].Name != anyone {
+		t.Errorf("expected Anyone and the three groups, got: %+v", groups)
+	}
+
+	err = client.Permissions.AddUser(permissions.AddUserRequest{Login: UserLogin, Permission: "gateadmin", ProjectKey: ProjectKey})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected adding a global permission to a project to be a bad request, got: %+v", err)
+	}
+
+	err = client.Permissions.AddGroup(permissions.AddGroupRequest{GroupName: "anyone", Permission: "codeviewer", ProjectKey: ProjectKey})
+	if err != nil {
+		t.Errorf("expected the name of Anyone to be case insensitive, got: %+v", err)
+	}
+
+	templateRequest := templateGroupsRequest{TemplateId: server.defaultTemplate, Q: "Me"}
+	_, err = sonarcloud.GetAll[templateGroupsRequest, permissionsResponseItem](client, "/permissions/template_groups", templateRequest, "groups")
+	if err == nil || !strings.Contains(err.Error(), "(400)") {
+		t.Errorf("expected a query shorter than 3 characters to be a bad request, got: %+v", err)
+	}
+}
+
+func TestServerUserGroups(t *testing.T) {
+	_, client := newTestClient(t, Organization, Token)
+
+	created, err := client.UserGroups.Create(user_groups.CreateRequest{Name: "developers"})
+	if err != nil {
+		t.Fatalf("could not create group: %+v", err)
+	}
+	id := strconv.Itoa(int(created.Group.Id))
+
+	err = client.UserGroups.AddUser(user_groups.AddUserRequest{Id: id, Login: NewMemberLogin})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected adding a non-member to a group to be a bad request, got: %+v", err)
+	}
+	if err := client.UserGroups.AddUser(user_groups.AddUserRequest{Id: id, Login: UserLogin}); err != nil {
+		t.Fatalf("could not add user to group: %+v", err)
+	}
+
+	users, err := client.UserGroups.UsersAll(user_groups.UsersRequest{Name: "developers"})
+	if err != nil {
+		t.Fatalf("could not list group members: %+v", err)
+	}
+	if len(users.Users) != 1 || users.Users[0].Login != UserLogin {
+		t.Errorf("expected %q to be the only member, got: %+v", UserLogin, users.Users)
+	}
+}
+
+func TestServerQualityGates(t *testing.T) {
+	server, client := newTestClient(t, Organization, Token)
+	gateID := server.Env()["SONARCLOUD_QUALITY_GATE_ID"]
+
+	if err := client.Qualitygates.Select(qualitygates.SelectRequest{GateId: gateID, ProjectKey: ProjectKey}); err != nil {
+		t.Fatalf("could not select quality gate: %+v", err)
+	}
+
+	response, err := client.Qualitygates.Search(qualitygates.SearchRequest{GateId: gateID})
+	if err != nil {
+		t.Fatalf("could not search quality gate projects: %+v", err)
+	}
+	if len(response.Results) != 1 || response.Results[0].Key != ProjectKey {
+		t.Errorf("expected %q to be selected, got: %+v", ProjectKey, response.Results)
+	}
+
+	list, err := client.Qualitygates.List(qualitygates.ListRequest{})
+	if err != nil {
+		t.Fatalf("could not list quality gates: %+v", err)
+	}
+	err = client.Qualitygates.Destroy(qualitygates.DestroyRequest{Id: strconv.Itoa(int(list.Default))})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected destroying the default quality gate to be a bad request, got: %+v", err)
+	}
+}
+
+func TestServerWebhooks(t *testing.T) {
+	_, client := newTestClient(t, Organization, Token)
+
+	request := webhooks.CreateRequest{Name: "test", Url: "https://example.com", Project: ProjectKey}
+	if _, err := client.Webhooks.Create(request); err != nil {
+		t.Fatalf("could not create webhook: %+v", err)
+	}
+
+	organization, err := client.Webhooks.List(webhooks.ListRequest{})
+	if err != nil {
+		t.Fatalf("could not list webhooks: %+v", err)
+	}
+	if len(organization.Webhooks) != 0 {
+		t.Errorf("expected the organization to have no webhooks, got: %+v", organization.Webhooks)
+	}
+
+	project, err := client.Webhooks.List(webhooks.ListRequest{Project: ProjectKey})
+	if err != nil {
+		t.Fatalf("could not list webhooks: %+v", err)
+	}
+	if len(project.Webhooks) != 1 || project.Webhooks[0].Name != "test" {
+		t.Errorf("expected the project to have the webhook, got: %+v", project.Webhooks)
+	}
+}
@@ -0,0 +1,221 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// setting is the value of a setting of a project
+type setting struct {
+	component   string
+	key         string
+	value       string
+	values      []string
+	fieldValues []map[string]string
+}
+
+func (s setting) json() map[string]interface{} {
+	result := map[string]interface{}{"key": s.key, "inherited": false}
+	switch {
+	case s.values != nil:
+		result["values"] = s.values
+	case s.fieldValues != nil:
+		result["fieldValues"] = s.fieldValues
+	default:
+		result["value"] = s.value
+	}
+	return result
+}
+
+// settingID returns the key of the setting in the settings of the server
+func settingID(component, key string) string {
+	return fmt.Sprintf("%s,%s", component, key)
+}
+
+// newCodePeriod is the new code period of the organization, a project or a branch
+type newCodePeriod struct {
+	periodType string
+	value      string
+}
+
+// newCodePeriodTypes are the types of new code periods
+var newCodePeriodTypes = []string{"PREVIOUS_VERSION", "NUMBER_OF_DAYS", "REFERENCE_BRANCH", "SPECIFIC_ANALYSIS"}
+
+func (s *Server) addSettingRoutes() {
+	s.handle("POST", "/settings/set", s.setSetting)
+	s.handle("GET", "/settings/values", s.settingValues)
+	s.handle("POST", "/settings/reset", s.resetSettings)
+
+	s.handle("POST", "/new_code_periods/set", s.setNewCodePeriod)
+	s.handle("POST", "/new_code_periods/unset", s.unsetNewCodePeriod)
+	s.handle("GET", "/new_code_periods/show", s.showNewCodePeriod)
+}
+
+// settingProject returns the project of the component parameter
+func (s *Server) settingProject(r request) (*project, error) {
+	if err := r.required("component"); err != nil {
+		return nil, err
+	}
+	p, err := s.findProject(r.param("component"))
+	if err != nil {
+		return nil, notFound("Component key '%s' not found", r.param("component"))
+	}
+	return p, nil
+}
+
+func (s *Server) setSetting(r request) (interface{}, error) {
+	p, err := s.settingProject(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("key"); err != nil {
+		return nil, err
+	}
+
+	value := setting{component: p.key, key: r.param("key"), value: r.param("value")}
+	set := 0
+	if value.value != "" {
+		set++
+	}
+	if values := r.params("values"); len(values) > 0 {
+		value.values = values
+		set++
+	}
+	if fieldValues := r.params("fieldValues"); len(fieldValues) > 0 {
+		for _, encoded := range fieldValues {
+			fields := make(map[string]string)
+			if err := json.Unmarshal([]byte(encoded), &fields); err != nil {
+				return nil, badRequest("JSON '%s' does not respect expected format for setting '%s'. Ex: {\"field1\":\"value1\", \"field2\":\"value2\"}", encoded, value.key)
+			}
+			value.fieldValues = append(value.fieldValues, fields)
+		}
+		set++
+	}
+	if set != 1 {
+		return nil, badRequest("Either 'value', 'values' or 'fieldValues' must be provided")
+	}
+
+	s.settings[settingID(p.key, value.key)] = value
+	return nil, nil
+}
+
+func (s *Server) settingValues(r request) (interface{}, error) {
+	p, err := s.settingProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make([]map[string]interface{}, 0)
+	for _, key := range r.list("keys") {
+		if value, ok := s.settings[settingID(p.key, key)]; ok {
+			settings = append(settings, value.json())
+		}
+	}
+	return map[string]interface{}{"settings": settings}, nil
+}
+
+func (s *Server) resetSettings(r request) (interface{}, error) {
+	p, err := s.settingProject(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("keys"); err != nil {
+		return nil, err
+	}
+
+	for _, key := range r.list("keys") {
+		delete(s.settings, settingID(p.key, key))
+	}
+	return nil, nil
+}
+
+// newCodePeriodScope returns the project of the new code period of the request, or nil for the organization
+func (s *Server) newCodePeriodScope(r request) (*project, error) {
+	if r.param("project") == "" {
+		if r.param("branch") != "" {
+			return nil, badRequest("If branch key is specified, project key needs to be specified too")
+		}
+		return nil, nil
+	}
+	p, err := s.findProject(r.param("project"))
+	if err != nil {
+		return nil, err
+	}
+	if branch := r.param("branch"); branch != "" && branch != p.mainBranch {
+		return nil, notFound("Branch '%s' in project '%s' not found", branch, p.key)
+	}
+	return p, nil
+}
+
+func (s *Server) setNewCodePeriod(r request) (interface{}, error) {
+	p, err := s.newCodePeriodScope(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("type"); err != nil {
+		return nil, err
+	}
+	if err := r.oneOf("type", newCodePeriodTypes...); err != nil {
+		return nil, err
+	}
+	period := newCodePeriod{periodType: r.param("type"), value: r.param("value")}
+	if period.periodType == "PREVIOUS_VERSION" && period.value != "" {
+		return nil, badRequest("Unexpected value for type '%s'", period.periodType)
+	}
+	if period.periodType != "PREVIOUS_VERSION" && period.value == "" {
+		return nil, badRequest("New code definition type '%s' requires a value", period.periodType)
+	}
+
+	if p == nil {
+		s.newCodePeriod = period
+		return nil, nil
+	}
+	if p.newCodePeriods == nil {
+		p.newCodePeriods = make(map[string]newCodePeriod)
+	}
+	p.newCodePeriods[r.param("branch")] = period
+	return nil, nil
+}
+
+func (s *Server) unsetNewCodePeriod(r request) (interface{}, error) {
+	p, err := s.newCodePeriodScope(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		s.newCodePeriod = newCodePeriod{periodType: "PREVIOUS_VERSION"}
+		return nil, nil
+	}
+	delete(p.newCodePeriods, r.param("branch"))
+	return nil, nil
+}
+
+func (s *Server) showNewCodePeriod(r request) (interface{}, error) {
+	p, err := s.newCodePeriodScope(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	period, inherited := s.newCodePeriod, p != nil
+	if p != nil {
+		result["projectKey"] = p.key
+		if branch := r.param("branch"); branch != "" {
+			result["branchKey"] = branch
+			if own, ok := p.newCodePeriods[branch]; ok {
+				period, inherited = own, false
+			} else if own, ok := p.newCodePeriods[""]; ok {
+				period = own
+			}
+		} else if own, ok := p.newCodePeriods[""]; ok {
+			period, inherited = own, false
+		}
+	}
+	result["type"] = period.periodType
+	if period.value != "" {
+		result["value"] = period.value
+	}
+	result["inherited"] = inherited
+	return result, nil
+}
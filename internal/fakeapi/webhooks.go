@@ -0,0 +1,118 @@
+package fakeapi
+
+import (
+	"sort"
+)
+
+// webhook is a webhook of the organization, or of a project if the project is set
+type webhook struct {
+	key     string
+	name    string
+	url     string
+	secret  string
+	project string
+}
+
+func (w *webhook) json() map[string]interface{} {
+	result := map[string]interface{}{
+		"key":  w.key,
+		"name": w.name,
+		"url":  w.url,
+	}
+	if w.secret != "" {
+		result["secret"] = w.secret
+	}
+	return result
+}
+
+func (s *Server) addWebhookRoutes() {
+	s.handle("POST", "/webhooks/create", s.createWebhook)
+	s.handle("POST", "/webhooks/update", s.updateWebhook)
+	s.handle("POST", "/webhooks/delete", s.deleteWebhook)
+	s.handle("GET", "/webhooks/list", s.listWebhooks)
+}
+
+// findWebhook returns the webhook with the key in the webhook parameter
+func (s *Server) findWebhook(r request) (*webhook, error) {
+	if err := r.required("webhook"); err != nil {
+		return nil, err
+	}
+	w, ok := s.webhooks[r.param("webhook")]
+	if !ok {
+		return nil, notFound("No webhook with key '%s'", r.param("webhook"))
+	}
+	return w, nil
+}
+
+func (s *Server) createWebhook(r request) (interface{}, error) {
+	if err := r.required("name", "url"); err != nil {
+		return nil, err
+	}
+	if key := r.param("project"); key != "" {
+		if _, err := s.findProject(key); err != nil {
+			return nil, err
+		}
+	}
+
+	w := &webhook{
+		key:     s.newKey("webhook"),
+		name:    r.param("name"),
+		url:     r.param("url"),
+		secret:  r.param("secret"),
+		project: r.param("project"),
+	}
+	s.webhooks[w.key] = w
+	return map[string]interface{}{"webhook": w.json()}, nil
+}
+
+func (s *Server) updateWebhook(r request) (interface{}, error) {
+	w, err := s.findWebhook(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.required("name", "url"); err != nil {
+		return nil, err
+	}
+
+	w.name = r.param("name")
+	w.url = r.param("url")
+	w.secret = r.param("secret")
+	return nil, nil
+}
+
+func (s *Server) deleteWebhook(r request) (interface{}, error) {
+	w, err := s.findWebhook(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.webhooks, w.key)
+	return nil, nil
+}
+
+func (s *Server) listWebhooks(r request) (interface{}, error) {
+	if key := r.param("project"); key != "" {
+		if _, err := s.findProject(key); err != nil {
+			return nil, err
+		}
+	}
+
+	var found []*webhook
+	for _, w := range s.webhooks {
+		if w.project == r.param("project") {
+			found = append(found, w)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].name == found[j].name {
+			return found[i].key < found[j].key
+		}
+		return found[i].name < found[j].name
+	})
+
+	webhooks := make([]map[string]interface{}, 0, len(found))
+	for _, w := range found {
+		webhooks = append(webhooks, w.json())
+	}
+	return map[string]interface{}{"webhooks": webhooks}, nil
+}
@@ -0,0 +1,46 @@
+package sonarcloud
+
+import (
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type AlmBindingGetResponse struct {
+	Alm                   string `json:"alm,omitempty"`
+	Key                   string `json:"key,omitempty"`
+	Monorepo              bool   `json:"monorepo,omitempty"`
+	Repository            string `json:"repository,omitempty"`
+	Slug                  string `json:"slug,omitempty"`
+	SummaryCommentEnabled bool   `json:"summaryCommentEnabled,omitempty"`
+	Url                   string `json:"url,omitempty"`
+}
+
+type AlmBindingDeleteRequest struct {
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+}
+
+// readAlmBinding returns the ALM binding of the project with the given key, if the project is bound to the given ALM
+func readAlmBinding(client *sonarcloud.Client, projectKey, alm string) (*AlmBindingGetResponse, bool, error) {
+	response, err := getWithResponse[AlmBindingGetResponse](client, "/alm_settings/get_binding", "project", projectKey)
+	if err != nil {
+		// The API responds with a 404 when the project is not bound
+		if isNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if response.Alm != alm {
+		return nil, false, nil
+	}
+	return response, true, nil
+}
+
+// deleteAlmBinding removes the ALM binding of the project with the given key
+func deleteAlmBinding(client *sonarcloud.Client, organization, projectKey string) error {
+	request := AlmBindingDeleteRequest{
+		Organization: organization,
+		Project:      projectKey,
+	}
+	return sonarcloud.Post(client, "/alm_settings/delete_binding", request)
+}
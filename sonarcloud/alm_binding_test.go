@@ -0,0 +1,78 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+func TestReadAlmBinding(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		alm     string
+		wantOk  bool
+		wantErr bool
+	}{
+		{
+			name:   "bound",
+			status: http.StatusOK,
+			body:   `{"alm":"github","repository":"my-org/my-repo","monorepo":true,"summaryCommentEnabled":true}`,
+			alm:    "github",
+			wantOk: true,
+		},
+		{
+			name:   "bound to another alm",
+			status: http.StatusOK,
+			body:   `{"alm":"gitlab","repository":"12345"}`,
+			alm:    "github",
+		},
+		{
+			name:   "not bound",
+			status: http.StatusNotFound,
+			body:   `{"errors":[{"msg":"Project 'my-project' is not bound to any ALM"}]}`,
+			alm:    "github",
+		},
+		{
+			name:    "forbidden",
+			status:  http.StatusForbidden,
+			body:    `{"errors":[{"msg":"Insufficient privileges"}]}`,
+			alm:     "github",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/alm_settings/get_binding" || r.URL.Query().Get("project") != "my-project" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+			if err != nil {
+				t.Fatalf("could not create http client: %+v", err)
+			}
+			client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+			got, ok, err := readAlmBinding(client, "my-project", tt.alm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got: %+v", tt.wantErr, err)
+			}
+			if ok != tt.wantOk {
+				t.Fatalf("expected ok: %t, got: %t", tt.wantOk, ok)
+			}
+			if ok && got.Repository != "my-org/my-repo" {
+				t.Errorf("expected repository 'my-org/my-repo', got: %q", got.Repository)
+			}
+		})
+	}
+}
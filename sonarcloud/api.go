@@ -0,0 +1,38 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// getWithResponse sends a GET request to an endpoint that is not covered by the client library and returns the
+// unmarshalled JSON response. The params must be interleaving param and value entries, i.e. ["key1", "value1"].
+// Note: the organization param is always added by the client.
+func getWithResponse[R any](client *sonarcloud.Client, path string, params ...string) (*R, error) {
+	req, err := client.GetRequest(sonarcloud.API+path, params...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %+v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error trying to execute request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		if errorResponse, err := sonarcloud.ErrorResponseFrom(resp); err != nil {
+			return nil, fmt.Errorf("received non 2xx status code (%d), but could not decode error response: %+v", resp.StatusCode, err)
+		} else {
+			return nil, errorResponse
+		}
+	}
+
+	response := new(R)
+	if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("could not decode response: %+v", err)
+	}
+	return response, nil
+}
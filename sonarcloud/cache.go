@@ -0,0 +1,175 @@
+package sonarcloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache caches the responses of GET requests to the API for as long as the provider stays configured, which is a
+// single Terraform run. Any other request might change what the API returns, so it clears the whole cache.
+// Concurrent requests for the same URL share a single request to the API.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+// readCacheEntry is the response to a GET request, which is available once done is closed
+type readCacheEntry struct {
+	done      chan struct{}
+	status    int
+	header    http.Header
+	body      []byte
+	err       error
+	cancelled bool
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: make(map[string]*readCacheEntry)}
+}
+
+// roundTrip returns the cached response to the request, or sends the request using base if there is none
+func (c *readCache) roundTrip(req *http.Request, base http.RoundTripper) (*http.Response, error) {
+	key := req.URL.String()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &readCacheEntry{done: make(chan struct{})}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	if ok {
+		select {
+		case <-entry.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		tflog.Trace(req.Context(), "Serving SonarCloud API request from the read cache", map[string]interface{}{
+			"endpoint": req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+		})
+	} else {
+		entry.fill(req, base)
+
+		// Failures are not cached, so that the next request tries again. The entry is removed before the waiting
+		// requests are released, so that the ones that try again do not find it.
+		if entry.err != nil || entry.status >= 300 {
+			c.remove(key, entry)
+		}
+		close(entry.done)
+	}
+
+	if entry.err != nil {
+		// The context of the request that filled the entry is not ours, so its cancellation must not fail this request
+		if ok && entry.cancelled && req.Context().Err() == nil {
+			return c.roundTrip(req, base)
+		}
+		return nil, entry.err
+	}
+	return entry.response(req), nil
+}
+
+// invalidate clears the cache
+func (c *readCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*readCacheEntry)
+}
+
+// remove removes the entry from the cache, unless it has been replaced already
+func (c *readCache) remove(key string, entry *readCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+}
+
+// fill sends the request and stores its response in the entry. The caller closes done once the entry is complete.
+func (e *readCacheEntry) fill(req *http.Request, base http.RoundTripper) {
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		e.err = err
+		e.cancelled = req.Context().Err() != nil
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		e.err = err
+		e.cancelled = req.Context().Err() != nil
+		return
+	}
+
+	e.status = resp.StatusCode
+	e.header = resp.Header.Clone()
+	e.body = body
+}
+
+// response returns a new response to the request with the stored status, headers and body
+func (e *readCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// readCacheTransport serves GET requests from the read cache and clears the cache on every other request
+type readCacheTransport struct {
+	base  http.RoundTripper
+	cache *readCache
+}
+
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// Clear the cache before and after the request, so that no reads that overlap with it are kept
+		t.cache.invalidate()
+		defer t.cache.invalidate()
+		return t.base.RoundTrip(req)
+	}
+
+	if readCacheBypassed(req.Context()) {
+		return t.base.RoundTrip(req)
+	}
+	return t.cache.roundTrip(req, t.base)
+}
+
+// withReadCache returns a copy of the given client that serves its GET requests from the cache
+func withReadCache(client *http.Client, cache *readCache) *http.Client {
+	c := *client
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c.Transport = &readCacheTransport{base: base, cache: cache}
+	return &c
+}
+
+type readCacheBypassKey struct{}
+
+// withoutReadCache returns a context of which the GET requests skip the read cache. Operations that change resources
+// use it, as they often wait for their changes to show up in the API and must not be served an earlier response.
+func withoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheBypassKey{}, true)
+}
+
+// readCacheBypassed reports whether the GET requests with the context skip the read cache
+func readCacheBypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(readCacheBypassKey{}).(bool)
+	return bypassed
+}
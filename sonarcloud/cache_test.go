@@ -0,0 +1,168 @@
+package sonarcloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadCache(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(r.URL.RawQuery))
+	}))
+	defer server.Close()
+
+	client := withReadCache(server.Client(), newReadCache())
+	get := func(ctx context.Context, query string) string {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?"+query, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+	expectCalls := func(step string, want int32) {
+		if got := atomic.SwapInt32(&calls, 0); got != want {
+			t.Errorf("%s: expected %d calls to the API, got: %d", step, want, got)
+		}
+	}
+
+	ctx := context.Background()
+	if body := get(ctx, "q=a"); body != "q=a" {
+		t.Errorf("expected body %q, got: %q", "q=a", body)
+	}
+	if body := get(ctx, "q=a"); body != "q=a" {
+		t.Errorf("expected the cached body %q, got: %q", "q=a", body)
+	}
+	expectCalls("same request", 1)
+
+	get(ctx, "q=b")
+	expectCalls("other parameters", 1)
+
+	resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	resp.Body.Close()
+	get(ctx, "q=a")
+	expectCalls("after a write", 2)
+
+	get(withoutReadCache(ctx), "q=c")
+	get(withoutReadCache(ctx), "q=c")
+	get(ctx, "q=c")
+	expectCalls("bypassing the cache", 3)
+
+	get(ctx, "fail=1")
+	get(ctx, "fail=1")
+	expectCalls("failed requests", 2)
+}
+
+func TestReadCacheConcurrent(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := withReadCache(server.Client(), newReadCache())
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %+v", err)
+				return
+			}
+			defer resp.Body.Close()
+			if body, _ := io.ReadAll(resp.Body); string(body) != "ok" {
+				t.Errorf("expected body %q, got: %q", "ok", body)
+			}
+		}()
+	}
+
+	// Give all requests the time to wait for the first one
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected the concurrent requests to share a single call to the API, got: %d", calls)
+	}
+}
+
+func TestReadCacheCancelled(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := withReadCache(server.Client(), newReadCache())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		first <- err
+	}()
+
+	second := make(chan string, 1)
+	go func() {
+		// Give the first request the time to fill the cache
+		time.Sleep(50 * time.Millisecond)
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Errorf("expected the waiting request not to fail with the context of another, got: %+v", err)
+			second <- ""
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		second <- string(body)
+	}()
+
+	// Give the second request the time to wait for the first one
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	if err := <-first; err == nil {
+		t.Errorf("expected the cancelled request to fail")
+	}
+	if body := <-second; body != "ok" {
+		t.Errorf("expected body %q, got: %q", "ok", body)
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 2 {
+		t.Errorf("expected the waiting request to call the API again, got: %d calls", calls)
+	}
+}
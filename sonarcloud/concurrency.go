@@ -0,0 +1,44 @@
+package sonarcloud
+
+import (
+	"context"
+	"sync"
+)
+
+// maxConcurrentRequests is the maximum number of requests that a resource sends to SonarCloud at once
+const maxConcurrentRequests = 5
+
+// forEachConcurrently calls fn for every item, with at most maxConcurrentRequests calls running at once, and waits for
+// all of them to finish. The returned errors line up with the items, and are nil for the calls that succeeded. Once ctx
+// is done no new calls are started, and the error of every skipped item is the error of ctx.
+func forEachConcurrently[T any](ctx context.Context, items []T, fn func(T) error) []error {
+	errs := make([]error, len(items))
+	sem := make(chan struct{}, maxConcurrentRequests)
+	wg := sync.WaitGroup{}
+
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// Every call writes to its own index, so no further locking is needed
+			errs[i] = fn(item)
+		}(i, item)
+	}
+
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,78 @@
+package sonarcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var running, maxRunning int32
+	errs := forEachConcurrently(context.Background(), items, func(item int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if item%3 == 0 {
+			return fmt.Errorf("item %d failed", item)
+		}
+		return nil
+	})
+
+	if len(errs) != len(items) {
+		t.Fatalf("expected %d errors, got: %d", len(items), len(errs))
+	}
+	for i, err := range errs {
+		if i%3 == 0 && (err == nil || err.Error() != fmt.Sprintf("item %d failed", i)) {
+			t.Errorf("expected an error for item %d, got: %v", i, err)
+		}
+		if i%3 != 0 && err != nil {
+			t.Errorf("expected no error for item %d, got: %v", i, err)
+		}
+	}
+	if maxRunning > maxConcurrentRequests {
+		t.Errorf("expected at most %d concurrent calls, got: %d", maxConcurrentRequests, maxRunning)
+	}
+}
+
+func TestForEachConcurrentlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	items := make([]int, 20)
+
+	var calls int32
+	errs := forEachConcurrently(ctx, items, func(_ int) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			cancel()
+		}
+		// Hold on to the slot, so that only a few calls can start before the loop notices the cancellation
+		<-ctx.Done()
+		return nil
+	})
+
+	if calls == int32(len(items)) {
+		t.Errorf("expected the calls to stop after cancellation")
+	}
+	cancelled := 0
+	for _, err := range errs {
+		if errors.Is(err, context.Canceled) {
+			cancelled++
+		}
+	}
+	if cancelled+int(calls) != len(items) {
+		t.Errorf("expected every skipped item to report the cancellation, got %d calls and %d cancellations", calls, cancelled)
+	}
+}
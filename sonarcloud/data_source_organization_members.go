@@ -0,0 +1,91 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceOrganizationMembersType struct{}
+
+func (d dataSourceOrganizationMembersType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves a list of members of the configured organization.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"query": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return members of which the login or name contains this string.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(2, 255),
+				},
+			},
+			"users": {
+				Computed:    true,
+				Description: "The members of the organization.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"login": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The login of this user",
+					},
+					"name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of this user",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceOrganizationMembersType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceOrganizationMembers{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceOrganizationMembers struct {
+	p provider
+}
+
+func (d dataSourceOrganizationMembers) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var config OrganizationMembers
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := searchOrganizationMembers(d.p.client, config.Query.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the organization members",
+			fmt.Sprintf("The SearchMembers request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result := config
+	allUsers := make([]User, len(members))
+	for i, member := range members {
+		allUsers[i] = User{
+			Login: types.String{Value: member.Login},
+			Name:  types.String{Value: member.Name},
+		}
+	}
+	result.Users = allUsers
+	result.ID = types.String{Value: d.p.organization}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
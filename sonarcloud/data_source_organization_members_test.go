@@ -0,0 +1,48 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceOrganizationMembers(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrganizationMembersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization_members.test", "users.#"),
+				),
+			},
+			{
+				Config: testAccDataSourceOrganizationMembersQueryConfig(login),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_organization_members.test", "query", login),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_organization_members.test", "users.*", map[string]string{
+						"login": login,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrganizationMembersConfig() string {
+	return `
+data "sonarcloud_organization_members" "test" {}
+`
+}
+
+func testAccDataSourceOrganizationMembersQueryConfig(query string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_organization_members" "test" {
+	query = "%s"
+}
+`, query)
+}
@@ -78,10 +78,11 @@ func (d dataSourceProjectLinks) Read(ctx context.Context, req tfsdk.ReadDataSour
 
 	response, err := d.p.client.ProjectLinks.Search(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project's links",
 			fmt.Sprintf("The Search request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
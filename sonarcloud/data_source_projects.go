@@ -6,19 +6,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+	"strings"
 )
 
 type dataSourceProjectsType struct{}
 
 func (d dataSourceProjectsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		Description: "This data source retrieves a list of projects for the configured organization.",
+		Description: "This data source retrieves a list of projects for the configured organization. " +
+			"The optional filters can be combined, in which case only the projects that match all of them are returned.",
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"query": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return projects of which the name or key contains this string.",
+			},
+			"keys": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Only return the projects with these keys.",
+			},
+			"visibility": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return projects with this visibility, either `public` or `private`.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("public", "private"),
+				},
+			},
+			"analyzed_before": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "Only return projects of which the last analysis is older than this date (exclusive), " +
+					"e.g. `2022-01-31` or `2022-01-31T13:00:00+0100`. Projects that have never been analyzed are not returned.",
+			},
+			"on_provisioned_only": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Only return projects that have been provisioned, but never analyzed.",
+			},
+			"tags": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Only return projects that have at least one of these tags.",
+			},
 			"projects": {
 				Computed:    true,
 				Description: "The projects of this organization.",
@@ -43,6 +80,11 @@ func (d dataSourceProjectsType) GetSchema(_ context.Context) (tfsdk.Schema, diag
 						Computed:    true,
 						Description: "The visibility of the project.",
 					},
+					"tags": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Computed:    true,
+						Description: "The tags of the project.",
+					},
 				}),
 			},
 		},
@@ -60,28 +102,72 @@ type dataSourceProjects struct {
 }
 
 func (d dataSourceProjects) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
-	var diags diag.Diagnostics
+	var config Projects
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	request := projects.SearchRequest{}
+	request := projects.SearchRequest{
+		AnalyzedBefore: config.AnalyzedBefore.Value,
+		Projects:       commaSeparatedSet(config.Keys),
+		Q:              config.Query.Value,
+	}
+	if config.OnProvisionedOnly.Value {
+		request.OnProvisionedOnly = "true"
+	}
 
 	response, err := d.p.client.Projects.SearchAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
-	result := Projects{}
-	allProjects := make([]Project, len(response.Components))
+	matches := make([]int, 0, len(response.Components))
 	for i, component := range response.Components {
-		allProjects[i] = Project{
+		if config.Visibility.Null || component.Visibility == config.Visibility.Value {
+			matches = append(matches, i)
+		}
+	}
+
+	keys := make([]string, 0, len(matches))
+	for _, i := range matches {
+		keys = append(keys, response.Components[i].Key)
+	}
+
+	// The tags are not part of the projects search response, so they are retrieved separately
+	tags, err := readProjectTags(d.p.client, keys, config.Tags)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the project tags",
+			fmt.Sprintf("Reading the tags of the projects returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result := config
+	allProjects := make([]DataProject, 0, len(matches))
+	for _, i := range matches {
+		component := response.Components[i]
+		projectTags, ok := tags[component.Key]
+		if !ok {
+			// The project does not have any of the tags that are filtered on
+			continue
+		}
+
+		allProjects = append(allProjects, DataProject{
 			ID:         types.String{Value: component.Name},
 			Name:       types.String{Value: component.Name},
 			Key:        types.String{Value: component.Key},
 			Visibility: types.String{Value: component.Visibility},
-		}
+			Tags:       stringSetOf(projectTags),
+		})
 	}
 	result.Projects = allProjects
 	result.ID = types.String{Value: d.p.organization}
@@ -90,3 +176,88 @@ func (d dataSourceProjects) Read(ctx context.Context, req tfsdk.ReadDataSourceRe
 
 	resp.Diagnostics.Append(diags...)
 }
+
+type ProjectsSearchProjectsRequest struct {
+	F      string
+	Filter string
+}
+
+type ProjectsSearchProjectsComponent struct {
+	Key  string   `json:"key,omitempty"`
+	Name string   `json:"name,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// projectTagsReadLimit is the number of projects up to which the tags are read per project. The tags of more projects
+// are read with a single search instead, which pages through all projects of the organization with any of the tags.
+const projectTagsReadLimit = 20
+
+// readProjectTags returns the tags of the projects with the given keys, leaving out the projects that do not have any
+// of the given tags. The search_projects filter does not support filtering on keys, so the search results are matched
+// against the keys locally.
+func readProjectTags(client *sonarcloud.Client, keys []string, tags types.Set) (map[string][]string, error) {
+	result := make(map[string][]string, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	if len(keys) <= projectTagsReadLimit {
+		for _, key := range keys {
+			response, err := getWithResponse[ProjectTagsComponentShowResponse](client, "/components/show", "component", key)
+			if err != nil {
+				return nil, err
+			}
+			if hasAnyTag(response.Component.Tags, tags) {
+				result[key] = response.Component.Tags
+			}
+		}
+		return result, nil
+	}
+
+	wanted := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		wanted[key] = struct{}{}
+	}
+
+	request := ProjectsSearchProjectsRequest{
+		F:      "tags",
+		Filter: projectTagsFilter(tags),
+	}
+	components, err := sonarcloud.GetAll[ProjectsSearchProjectsRequest, ProjectsSearchProjectsComponent](client, "/components/search_projects", request, "components")
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range components {
+		if _, ok := wanted[component.Key]; ok {
+			result[component.Key] = component.Tags
+		}
+	}
+	return result, nil
+}
+
+// hasAnyTag returns true if projectTags contains any of the given tags, or if no tags are given
+func hasAnyTag(projectTags []string, tags types.Set) bool {
+	if tags.Null || tags.Unknown || len(tags.Elems) == 0 {
+		return true
+	}
+	for _, elem := range tags.Elems {
+		tag, ok := elem.(types.String)
+		if !ok {
+			continue
+		}
+		for _, projectTag := range projectTags {
+			if projectTag == tag.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// projectTagsFilter returns a search_projects filter that matches projects with any of the given tags
+func projectTagsFilter(tags types.Set) string {
+	if tags.Null || tags.Unknown || len(tags.Elems) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("tags in (%s)", strings.ReplaceAll(commaSeparatedSet(tags), ",", ", "))
+}
@@ -3,11 +3,17 @@ package sonarcloud
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 )
 
 func TestAccDataSourceProjects(t *testing.T) {
 	numberOfDefaultProjects := "1"
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -19,6 +25,25 @@ func TestAccDataSourceProjects(t *testing.T) {
 					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.#", numberOfDefaultProjects),
 				),
 			},
+			{
+				Config: testAccDataSourceProjectsFilterConfig(fmt.Sprintf(`keys = ["%s"]`, projectKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.0.key", projectKey),
+				),
+			},
+			{
+				Config: testAccDataSourceProjectsFilterConfig(fmt.Sprintf(`query = "%s"`, projectKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.0.key", projectKey),
+				),
+			},
+			{
+				Config: testAccDataSourceProjectsFilterConfig(`analyzed_before = "1970-01-02"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -28,3 +53,89 @@ func testAccDataSourceProjectsConfig() string {
 data "sonarcloud_projects" "test_projects" {}
 `)
 }
+
+func testAccDataSourceProjectsFilterConfig(filter string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_projects" "test_projects" {
+	%s
+}
+`, filter)
+}
+
+func TestReadProjectTags(t *testing.T) {
+	var showRequests []string
+	var searchFilters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/components/show":
+			key := r.URL.Query().Get("component")
+			showRequests = append(showRequests, key)
+			_, _ = fmt.Fprintf(w, `{"component":{"key":%q,"tags":["%s-tag"]}}`, key, key)
+		case "/api/components/search_projects":
+			searchFilters = append(searchFilters, r.URL.Query().Get("filter"))
+			components := make([]string, 0, projectTagsReadLimit+2)
+			for i := 0; i < projectTagsReadLimit+2; i++ {
+				components = append(components, fmt.Sprintf(`{"key":"project-%d","tags":["shared"]}`, i))
+			}
+			_, _ = fmt.Fprintf(w, `{"paging":{"pageIndex":1,"pageSize":500,"total":%d},"components":[%s]}`,
+				len(components), strings.Join(components, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+	if err != nil {
+		t.Fatalf("could not create http client: %+v", err)
+	}
+	client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+	t.Run("few projects are read one by one", func(t *testing.T) {
+		showRequests, searchFilters = nil, nil
+
+		tags, err := readProjectTags(client, []string{"a", "b"}, stringSetOf([]string{"b-tag"}))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if len(searchFilters) != 0 {
+			t.Errorf("expected no project search, got: %v", searchFilters)
+		}
+		if len(showRequests) != 2 {
+			t.Errorf("expected 2 show requests, got: %v", showRequests)
+		}
+		if _, ok := tags["a"]; ok {
+			t.Errorf("expected project 'a' to be filtered out, got: %v", tags)
+		}
+		if got := tags["b"]; len(got) != 1 || got[0] != "b-tag" {
+			t.Errorf("expected the tags of project 'b', got: %v", tags)
+		}
+	})
+
+	t.Run("many projects are searched by tag", func(t *testing.T) {
+		showRequests, searchFilters = nil, nil
+
+		keys := make([]string, 0, projectTagsReadLimit+1)
+		for i := 1; i <= projectTagsReadLimit+1; i++ {
+			keys = append(keys, fmt.Sprintf("project-%d", i))
+		}
+
+		tags, err := readProjectTags(client, keys, stringSetOf([]string{"shared"}))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if len(showRequests) != 0 {
+			t.Errorf("expected no show requests, got: %v", showRequests)
+		}
+		if len(searchFilters) != 1 || searchFilters[0] != "tags in (shared)" {
+			t.Errorf("expected a single search filtered on tags only, got: %v", searchFilters)
+		}
+		if len(tags) != len(keys) {
+			t.Errorf("expected the tags of %d projects, got: %v", len(keys), tags)
+		}
+		if _, ok := tags["project-0"]; ok {
+			t.Errorf("expected projects that were not asked for to be left out, got: %v", tags)
+		}
+	})
+}
@@ -99,10 +99,11 @@ func (d dataSourceQualityGate) Read(ctx context.Context, req tfsdk.ReadDataSourc
 
 	response, err := d.p.client.Qualitygates.List(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the Quality Gate",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -70,10 +70,11 @@ func (d dataSourceUserGroup) Read(ctx context.Context, req tfsdk.ReadDataSourceR
 
 	response, err := d.p.client.UserGroups.SearchAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the user_group",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
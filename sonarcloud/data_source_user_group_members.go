@@ -69,10 +69,11 @@ func (d dataSourceUserGroupMembers) Read(ctx context.Context, req tfsdk.ReadData
 
 	res, err := d.p.client.UserGroups.UsersAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read user_group_members.",
 			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -78,10 +78,11 @@ func (d dataSourceUserGroupPermissions) Read(ctx context.Context, req tfsdk.Read
 	searchRequest := UserGroupPermissionsSearchRequest{ProjectKey: config.ProjectKey.Value}
 	groups, err := sonarcloud.GetAll[UserGroupPermissionsSearchRequest, UserGroupPermissionsSearchResponseGroup](d.p.client, "/permissions/groups", searchRequest, "groups")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not get user group permissions",
 			fmt.Sprintf("The request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -73,10 +73,11 @@ func (d dataSourceUserGroups) Read(ctx context.Context, req tfsdk.ReadDataSource
 
 	res, err := d.p.client.UserGroups.SearchAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read user_groups",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
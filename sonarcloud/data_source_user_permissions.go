@@ -81,10 +81,11 @@ func (d dataSourceUserPermissions) Read(ctx context.Context, req tfsdk.ReadDataS
 	searchRequest := UserPermissionsSearchRequest{ProjectKey: config.ProjectKey.Value}
 	users, err := sonarcloud.GetAll[UserPermissionsSearchRequest, UserPermissionsSearchResponseUser](d.p.client, "/permissions/users", searchRequest, "users")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not get user permissions",
 			fmt.Sprintf("The request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -81,10 +81,11 @@ func (d dataSourceWebhooks) Read(ctx context.Context, req tfsdk.ReadDataSourceRe
 
 	response, err := d.p.client.Webhooks.List(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the webhooks",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
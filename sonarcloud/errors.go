@@ -0,0 +1,135 @@
+package sonarcloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// statusCodePattern matches the status code in the errors of the client. Paginated requests wrap the error responses
+// of the API as text, so this is the only way to recognise them.
+var statusCodePattern = regexp.MustCompile(`received non 2xx status code \((\d{3})\)`)
+
+// apiError is an error response of the API
+type apiError struct {
+	StatusCode int
+	Messages   []string
+}
+
+// apiErrorFrom returns the error response of the API that caused err, if any
+func apiErrorFrom(err error) (*apiError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var errorResponse *sonarcloud.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		messages := make([]string, len(errorResponse.Errors))
+		for i, e := range errorResponse.Errors {
+			messages[i] = e.Msg
+		}
+		return &apiError{StatusCode: errorResponse.StatusCode, Messages: messages}, true
+	}
+
+	message := err.Error()
+	match := statusCodePattern.FindStringSubmatchIndex(message)
+	if match == nil {
+		return nil, false
+	}
+	statusCode, _ := strconv.Atoi(message[match[2]:match[3]])
+
+	result := &apiError{StatusCode: statusCode}
+	if rest := message[match[1]:]; strings.HasPrefix(rest, ": ") && len(rest) > 2 {
+		result.Messages = strings.Split(rest[2:], ",")
+	}
+	return result, true
+}
+
+// alreadyExists reports whether the request failed because the object it creates exists already. The API returns
+// a 400 for most of these, so the messages are checked as well.
+func (e *apiError) alreadyExists() bool {
+	if e.StatusCode == http.StatusConflict {
+		return true
+	}
+	if e.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, message := range e.Messages {
+		if strings.Contains(strings.ToLower(message), "already exist") {
+			return true
+		}
+	}
+	return false
+}
+
+// reason returns a short description of the error and a hint on how to resolve it, or false if there is none
+func (e *apiError) reason() (string, string, bool) {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return "authentication failed",
+			"SonarCloud rejected the token. Check that the token in the provider configuration or the SONARCLOUD_TOKEN " +
+				"environment variable is valid, and that it has not expired or been revoked.",
+			true
+	case e.StatusCode == http.StatusForbidden:
+		return "insufficient permissions",
+			"The user of the token lacks a permission that the request requires. Most resources require the token to " +
+				"have the Administer Organization permission, while project level resources can do with the Administer " +
+				"permission on the project.",
+			true
+	case e.StatusCode == http.StatusNotFound:
+		return "not found",
+			"The object does not exist, or is not visible to the user of the token. Check that the keys and names in " +
+				"the configuration are correct, and that the object belongs to the configured organization.",
+			true
+	case e.alreadyExists():
+		return "already exists",
+			"An object with the same key or name exists already. Import it into the state with `terraform import` to " +
+				"manage it with Terraform, or use another key or name.",
+			true
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "rate limited",
+			"SonarCloud kept rate limiting the requests after all retries. Try again later, or raise max_retries and " +
+				"retry_wait_max in the provider configuration.",
+			true
+	case e.StatusCode >= 500:
+		return "SonarCloud is unavailable",
+			"SonarCloud could not handle the request. This is usually temporary, try again later.",
+			true
+	}
+	return "", "", false
+}
+
+// apiErrorDiagnostic returns the error diagnostic for a failed request to the API. If err is an error response of the
+// API that is understood, the summary is extended with the reason of the error and the detail with how to resolve it.
+func apiErrorDiagnostic(summary, detail string, err error) diag.Diagnostic {
+	summary, detail = explainAPIError(summary, detail, err)
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
+// apiAttributeErrorDiagnostic is like apiErrorDiagnostic, but for a request that failed because of the attribute at p
+func apiAttributeErrorDiagnostic(p path.Path, summary, detail string, err error) diag.Diagnostic {
+	summary, detail = explainAPIError(summary, detail, err)
+	return diag.NewAttributeErrorDiagnostic(p, summary, detail)
+}
+
+func explainAPIError(summary, detail string, err error) (string, string) {
+	if e, ok := apiErrorFrom(err); ok {
+		if reason, hint, ok := e.reason(); ok {
+			return fmt.Sprintf("%s: %s", summary, reason), fmt.Sprintf("%s\n\n%s", detail, hint)
+		}
+	}
+	return summary, detail
+}
+
+// isNotFound reports whether err is an error response of the API for an object that does not exist
+func isNotFound(err error) bool {
+	e, ok := apiErrorFrom(err)
+	return ok && e.StatusCode == http.StatusNotFound
+}
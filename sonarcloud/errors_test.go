@@ -0,0 +1,173 @@
+package sonarcloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+)
+
+func TestAPIErrorFrom(t *testing.T) {
+	errorResponse := &sonarcloud.ErrorResponse{StatusCode: http.StatusNotFound}
+	errorResponse.Errors = append(errorResponse.Errors, struct {
+		Msg string `json:"msg"`
+	}{Msg: "Project 'my-project' not found"})
+
+	tests := []struct {
+		name string
+		err  error
+		want *apiError
+	}{
+		{
+			name: "error response",
+			err:  errorResponse,
+			want: &apiError{StatusCode: http.StatusNotFound, Messages: []string{"Project 'my-project' not found"}},
+		},
+		{
+			name: "wrapped error response",
+			err:  fmt.Errorf("error during call to /projects/search: , %+v", errorResponse),
+			want: &apiError{StatusCode: http.StatusNotFound, Messages: []string{"Project 'my-project' not found"}},
+		},
+		{
+			name: "undecodable error response",
+			err:  errors.New("received non 2xx status code (401), but could not decode error response: EOF"),
+			want: &apiError{StatusCode: http.StatusUnauthorized},
+		},
+		{
+			name: "other error",
+			err:  errors.New("error trying to execute request: connection refused"),
+		},
+		{
+			name: "no error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := apiErrorFrom(tt.err)
+			if ok != (tt.want != nil) {
+				t.Fatalf("apiErrorFrom() ok = %v, want %v", ok, tt.want != nil)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("apiErrorFrom() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDiagnostic(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantSummary string
+		wantHint    string
+	}{
+		{
+			name:        "unauthorized",
+			err:         errors.New("received non 2xx status code (401), but could not decode error response: EOF"),
+			wantSummary: "Could not create the project: authentication failed",
+			wantHint:    "SONARCLOUD_TOKEN",
+		},
+		{
+			name:        "forbidden",
+			err:         errors.New("received non 2xx status code (403): Insufficient privileges"),
+			wantSummary: "Could not create the project: insufficient permissions",
+			wantHint:    "Administer Organization permission",
+		},
+		{
+			name:        "not found",
+			err:         errors.New("received non 2xx status code (404): Organization not found"),
+			wantSummary: "Could not create the project: not found",
+			wantHint:    "configured organization",
+		},
+		{
+			name:        "conflict",
+			err:         errors.New("received non 2xx status code (409): "),
+			wantSummary: "Could not create the project: already exists",
+			wantHint:    "terraform import",
+		},
+		{
+			name:        "already exists",
+			err:         errors.New("received non 2xx status code (400): Could not create Project with key: \"my-project\". A similar key already exists: \"my-project\""),
+			wantSummary: "Could not create the project: already exists",
+			wantHint:    "terraform import",
+		},
+		{
+			name:        "bad request",
+			err:         errors.New("received non 2xx status code (400): The 'name' parameter is missing"),
+			wantSummary: "Could not create the project",
+		},
+		{
+			name:        "rate limited",
+			err:         errors.New("received non 2xx status code (429): "),
+			wantSummary: "Could not create the project: rate limited",
+			wantHint:    "max_retries",
+		},
+		{
+			name:        "unavailable",
+			err:         errors.New("received non 2xx status code (503): "),
+			wantSummary: "Could not create the project: SonarCloud is unavailable",
+			wantHint:    "try again later",
+		},
+		{
+			name:        "other error",
+			err:         errors.New("error trying to execute request: connection refused"),
+			wantSummary: "Could not create the project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := fmt.Sprintf("The Create request returned an error: %+v", tt.err)
+			got := apiErrorDiagnostic("Could not create the project", detail, tt.err)
+
+			if got.Summary() != tt.wantSummary {
+				t.Errorf("expected summary %q, got: %q", tt.wantSummary, got.Summary())
+			}
+			if !strings.HasPrefix(got.Detail(), detail) {
+				t.Errorf("expected the detail to start with %q, got: %q", detail, got.Detail())
+			}
+			if tt.wantHint == "" && got.Detail() != detail {
+				t.Errorf("expected no hint, got: %q", got.Detail())
+			}
+			if !strings.Contains(got.Detail(), tt.wantHint) {
+				t.Errorf("expected the detail to contain %q, got: %q", tt.wantHint, got.Detail())
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"msg":"Organization 'my-org' not found"}]}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+	if err != nil {
+		t.Fatalf("could not create http client: %+v", err)
+	}
+	client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+	_, err = client.Projects.SearchAll(projects.SearchRequest{})
+	if !isNotFound(err) {
+		t.Errorf("expected the error of a paginated request to be recognised as not found, got: %+v", err)
+	}
+
+	err = client.Projects.Delete(projects.DeleteRequest{Project: "my-project"})
+	if !isNotFound(err) {
+		t.Errorf("expected the error of a request to be recognised as not found, got: %+v", err)
+	}
+
+	if isNotFound(errors.New("error trying to execute request: connection refused")) {
+		t.Errorf("expected a failed connection not to be recognised as not found")
+	}
+}
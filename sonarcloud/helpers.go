@@ -1,9 +1,12 @@
 package sonarcloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/cenkalti/backoff/v4"
 	"math/big"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,28 +22,36 @@ import (
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_tokens"
 )
 
-// changedAttrs returns a map where the keys are the names of all the attributes that were changed
-// Note that the name is not the full path, but only the AttributeName of the last path step.
-func changedAttrs(req tfsdk.UpdateResourceRequest, diags diag.Diagnostics) map[string]struct{} {
+// changedAttrs returns a map where the keys are the names of all the top-level attributes that were changed
+// Note that a change to a nested value, e.g. an element of a set, is reported as a change of the attribute that holds it.
+func changedAttrs(req tfsdk.UpdateResourceRequest) (map[string]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	changes := make(map[string]struct{})
+
 	diffs, err := req.Plan.Raw.Diff(req.State.Raw)
 	if err != nil {
 		diags.AddError(
 			"Could not diff plan with state",
-			"This should not happen and is an error in the provider.",
+			fmt.Sprintf("This should not happen and is an error in the provider: %+v", err),
 		)
+		return changes, diags
 	}
 
-	changes := make(map[string]struct{})
 	for _, diff := range diffs {
 		steps := diff.Path.Steps()
-		index := len(steps) - 1
+		if len(steps) == 0 {
+			continue
+		}
+		// Either value is nil when it does not exist in the plan or the state
+		if diff.Value1 != nil && diff.Value2 != nil && diff.Value1.Equal(*diff.Value2) {
+			continue
+		}
 
-		if !diff.Value1.Equal(*diff.Value2) {
-			attr := steps[index].(tftypes.AttributeName)
+		if attr, ok := steps[0].(tftypes.AttributeName); ok {
 			changes[string(attr)] = struct{}{}
 		}
 	}
-	return changes
+	return changes, diags
 }
 
 // findGroup returns the group with the given name if it exists in the response
@@ -129,10 +140,20 @@ func findProjectMainBranch(response *project_branches.ListResponse, name, projec
 
 // findQualityGate returns the quality gate with the given name if it exists in a response
 func findQualityGate(response *qualitygates.ListResponse, name string) (QualityGate, bool) {
+	return findQualityGateWith(response, func(_ float64, n string) bool { return n == name })
+}
+
+// findQualityGateById returns the quality gate with the given ID if it exists in a response
+func findQualityGateById(response *qualitygates.ListResponse, id float64) (QualityGate, bool) {
+	return findQualityGateWith(response, func(i float64, _ string) bool { return i == id })
+}
+
+// findQualityGateWith returns the first quality gate in a response that matches the given function
+func findQualityGateWith(response *qualitygates.ListResponse, match func(id float64, name string) bool) (QualityGate, bool) {
 	var result QualityGate
 	ok := false
 	for _, q := range response.Qualitygates {
-		if q.Name == name {
+		if match(q.Id, q.Name) {
 			result = QualityGate{
 				ID:        types.String{Value: fmt.Sprintf("%d", int(q.Id))},
 				GateId:    types.Float64{Value: q.Id},
@@ -155,6 +176,31 @@ func findQualityGate(response *qualitygates.ListResponse, name string) (QualityG
 	return result, ok
 }
 
+// findBuiltInQualityGate returns the ID of the built-in quality gate (Sonar way) if it exists in a response
+func findBuiltInQualityGate(response *qualitygates.ListResponse) (string, bool) {
+	for _, q := range response.Qualitygates {
+		if q.IsBuiltIn {
+			return fmt.Sprintf("%d", int(q.Id)), true
+		}
+	}
+	return "", false
+}
+
+// findDefaultQualityGate returns a DefaultQualityGate{} struct for the default quality gate of the organization
+func findDefaultQualityGate(response *qualitygates.ListResponse, organization string) (DefaultQualityGate, bool) {
+	for _, q := range response.Qualitygates {
+		if q.IsDefault {
+			return DefaultQualityGate{
+				ID:        types.String{Value: organization},
+				GateId:    types.String{Value: fmt.Sprintf("%d", int(q.Id))},
+				Name:      types.String{Value: q.Name},
+				IsBuiltIn: types.Bool{Value: q.IsBuiltIn},
+			}, true
+		}
+	}
+	return DefaultQualityGate{}, false
+}
+
 // findSelection returns a Selection{} struct with the given project keys if they exist in a response
 // this can be sped up using hashmaps, but I didn't feel like introducing a new dependency/taking code from somewhere.
 // Ex library: https://pkg.go.dev/github.com/juliangruber/go-intersect/v2
@@ -181,40 +227,85 @@ func findSelection(response *qualitygates.SearchResponse, keys []attr.Value) (Se
 
 // terraformListString returns the list of items in terraform list notation
 func terraformListString(items []string) string {
-	return fmt.Sprintf(`["%s"]`, strings.Join(items, `","`))
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = strconv.Quote(item)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ","))
 }
 
-// defaultBackendConfig returns an exponential backoff with a timeout of 30 seconds instead of the module's default of 15 minutes
-func defaultBackoffConfig() *backoff.ExponentialBackOff {
+// defaultBackendConfig returns an exponential backoff with a timeout of 30 seconds instead of the module's default of 15 minutes.
+// It does not depend on the retry settings of the provider, but it does stop early once ctx is done.
+func defaultBackoffConfig(ctx context.Context) backoff.BackOff {
 	backoffConfig := backoff.NewExponentialBackOff()
 	backoffConfig.MaxInterval = 10 * time.Second
 	backoffConfig.MaxElapsedTime = 30 * time.Second
 	backoffConfig.InitialInterval = 250 * time.Millisecond
-	return backoffConfig
+	return backoff.WithContext(backoffConfig, ctx)
 }
 
-// stringAttributesContain checks if the given string is found in the list of attributes
-func stringAttributesContain(haystack []attr.Value, needle string) bool {
-	for _, v := range haystack {
-		if v.Equal(types.String{Value: needle}) {
-			return true
-		}
+// stringSetOf returns the given strings as a set
+func stringSetOf(values []string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.String{Value: v}
 	}
-	return false
+	return types.Set{ElemType: types.StringType, Elems: elems}
+}
+
+// commaSeparatedSet returns the string values in the set as a sorted, comma-separated list
+func commaSeparatedSet(set types.Set) string {
+	values := make([]string, 0, len(set.Elems))
+	for _, v := range set.Elems {
+		values = append(values, v.(types.String).Value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
 }
 
-// diffAttrSets returns the additions and deletions needed to get from the set we have, to the set we want
+// diffAttrSets returns the additions and deletions needed to get from the set we have, to the set we want.
+// Unknown and null elements are ignored, and every value is added or removed at most once.
 func diffAttrSets(haves, wants types.Set) (toAdd, toRemove []attr.Value) {
-	for _, have := range haves.Elems {
-		if !stringAttributesContain(wants.Elems, have.(types.String).Value) {
-			toRemove = append(toRemove, types.String{Value: have.(types.String).Value})
+	haveValues := knownStringSet(haves)
+	wantValues := knownStringSet(wants)
+
+	for _, value := range knownStrings(haves) {
+		if _, ok := wantValues[value]; !ok {
+			toRemove = append(toRemove, types.String{Value: value})
 		}
 	}
-	for _, want := range wants.Elems {
-		if !stringAttributesContain(haves.Elems, want.(types.String).Value) {
-			toAdd = append(toAdd, types.String{Value: want.(types.String).Value})
+	for _, value := range knownStrings(wants) {
+		if _, ok := haveValues[value]; !ok {
+			toAdd = append(toAdd, types.String{Value: value})
 		}
 	}
 
 	return toAdd, toRemove
 }
+
+// knownStrings returns the distinct known string values in the set, in order of appearance
+func knownStrings(set types.Set) []string {
+	seen := make(map[string]struct{})
+	values := make([]string, 0, len(set.Elems))
+	for _, elem := range set.Elems {
+		s, ok := elem.(types.String)
+		if !ok || s.Unknown || s.Null {
+			continue
+		}
+		if _, ok := seen[s.Value]; ok {
+			continue
+		}
+		seen[s.Value] = struct{}{}
+		values = append(values, s.Value)
+	}
+	return values
+}
+
+// knownStringSet returns the known string values in the set as a lookup map
+func knownStringSet(set types.Set) map[string]struct{} {
+	values := make(map[string]struct{})
+	for _, value := range knownStrings(set) {
+		values[value] = struct{}{}
+	}
+	return values
+}
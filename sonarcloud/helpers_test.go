@@ -0,0 +1,444 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/webhooks"
+)
+
+// stringValues returns the string values of the attributes in order
+func stringValues(values []attr.Value) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.(types.String).Value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestDiffAttrSets(t *testing.T) {
+	unknown := types.String{Unknown: true}
+	null := types.String{Null: true}
+
+	tests := []struct {
+		name       string
+		haves      types.Set
+		wants      types.Set
+		wantAdd    []string
+		wantRemove []string
+	}{
+		{
+			name:       "additions and removals",
+			haves:      stringSet("a", "b"),
+			wants:      stringSet("b", "c"),
+			wantAdd:    []string{"c"},
+			wantRemove: []string{"a"},
+		},
+		{
+			name:  "equal sets",
+			haves: stringSet("a", "b"),
+			wants: stringSet("b", "a"),
+		},
+		{
+			name: "empty sets",
+		},
+		{
+			name:    "from empty",
+			haves:   stringSet(),
+			wants:   stringSet("a", "b"),
+			wantAdd: []string{"a", "b"},
+		},
+		{
+			name:       "to empty",
+			haves:      stringSet("a", "b"),
+			wants:      stringSet(),
+			wantRemove: []string{"a", "b"},
+		},
+		{
+			name:    "from null",
+			haves:   types.Set{ElemType: types.StringType, Null: true},
+			wants:   stringSet("a"),
+			wantAdd: []string{"a"},
+		},
+		{
+			name:       "duplicates",
+			haves:      stringSet("a", "a", "b"),
+			wants:      stringSet("c", "c", "b"),
+			wantAdd:    []string{"c"},
+			wantRemove: []string{"a"},
+		},
+		{
+			name:    "unknown and null elements",
+			haves:   types.Set{ElemType: types.StringType, Elems: []attr.Value{null}},
+			wants:   types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, unknown, null}},
+			wantAdd: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := diffAttrSets(tt.haves, tt.wants)
+
+			if got := stringValues(toAdd); !reflect.DeepEqual(got, append([]string{}, tt.wantAdd...)) {
+				t.Errorf("expected to add %v, got: %v", tt.wantAdd, got)
+			}
+			if got := stringValues(toRemove); !reflect.DeepEqual(got, append([]string{}, tt.wantRemove...)) {
+				t.Errorf("expected to remove %v, got: %v", tt.wantRemove, got)
+			}
+		})
+	}
+}
+
+func TestDiffAttrSetsRoundTrip(t *testing.T) {
+	sets := []types.Set{stringSet(), stringSet("a"), stringSet("a", "b"), stringSet("b", "c", "d")}
+
+	// Applying the diff to the set we have always results in the set we want
+	for _, haves := range sets {
+		for _, wants := range sets {
+			toAdd, toRemove := diffAttrSets(haves, wants)
+
+			result := make(map[string]struct{})
+			for _, v := range stringValues(haves.Elems) {
+				result[v] = struct{}{}
+			}
+			for _, v := range stringValues(toRemove) {
+				delete(result, v)
+			}
+			for _, v := range stringValues(toAdd) {
+				result[v] = struct{}{}
+			}
+
+			got := make([]string, 0, len(result))
+			for v := range result {
+				got = append(got, v)
+			}
+			sort.Strings(got)
+			if want := stringValues(wants.Elems); !reflect.DeepEqual(got, want) {
+				t.Errorf("diff from %v to %v resulted in %v", haves, wants, got)
+			}
+		}
+	}
+}
+
+func condition(id float64, metric, op, error string) Condition {
+	return Condition{
+		Error:  types.String{Value: error},
+		ID:     types.Float64{Value: id},
+		Metric: types.String{Value: metric},
+		Op:     types.String{Value: op},
+	}
+}
+
+func TestDiffConditions(t *testing.T) {
+	unknownID := types.Float64{Unknown: true}
+	changed := condition(0, "coverage", "LT", "80")
+	changed.ID = unknownID
+	added := condition(0, "new_bugs", "GT", "0")
+	added.ID = unknownID
+
+	tests := []struct {
+		name       string
+		old        []Condition
+		new        []Condition
+		wantCreate []Condition
+		wantUpdate []Condition
+		wantRemove []Condition
+	}{
+		{
+			name: "no conditions",
+		},
+		{
+			name: "unchanged conditions",
+			old:  []Condition{condition(1, "coverage", "LT", "50"), condition(2, "bugs", "GT", "0")},
+			new:  []Condition{condition(2, "bugs", "GT", "0"), condition(1, "coverage", "LT", "50")},
+		},
+		{
+			name:       "changed condition keeps its ID",
+			old:        []Condition{condition(1, "coverage", "LT", "50"), condition(2, "bugs", "GT", "0")},
+			new:        []Condition{changed, condition(2, "bugs", "GT", "0")},
+			wantUpdate: []Condition{condition(1, "coverage", "LT", "80")},
+		},
+		{
+			name:       "added and removed conditions",
+			old:        []Condition{condition(1, "coverage", "LT", "50"), condition(2, "bugs", "GT", "0")},
+			new:        []Condition{condition(1, "coverage", "LT", "50"), added},
+			wantCreate: []Condition{added},
+			wantRemove: []Condition{condition(2, "bugs", "GT", "0")},
+		},
+		{
+			name:       "from no conditions",
+			new:        []Condition{added},
+			wantCreate: []Condition{added},
+		},
+		{
+			name:       "to no conditions",
+			old:        []Condition{condition(1, "coverage", "LT", "50")},
+			wantRemove: []Condition{condition(1, "coverage", "LT", "50")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			create, update, remove := diffConditions(tt.old, tt.new)
+
+			for _, c := range []struct {
+				kind      string
+				got, want []Condition
+			}{
+				{"create", create, tt.wantCreate},
+				{"update", update, tt.wantUpdate},
+				{"remove", remove, tt.wantRemove},
+			} {
+				if len(c.got) != len(c.want) {
+					t.Errorf("expected to %s %v, got: %v", c.kind, c.want, c.got)
+					continue
+				}
+				for i := range c.want {
+					if !reflect.DeepEqual(c.got[i], c.want[i]) {
+						t.Errorf("expected to %s %v, got: %v", c.kind, c.want, c.got)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestContainsCondition(t *testing.T) {
+	list := []Condition{condition(1, "coverage", "LT", "50"), condition(2, "bugs", "GT", "0")}
+
+	tests := []struct {
+		name string
+		list []Condition
+		item Condition
+		want bool
+	}{
+		{name: "same metric", list: list, item: condition(0, "coverage", "GT", "10"), want: true},
+		{name: "other metric", list: list, item: condition(1, "new_bugs", "LT", "50"), want: false},
+		{name: "empty list", item: condition(1, "coverage", "LT", "50"), want: false},
+		{name: "null metric", list: list, item: Condition{Metric: types.String{Null: true}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := containsCondition(tt.list, tt.item); got != tt.want {
+				t.Errorf("expected %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFindSelection(t *testing.T) {
+	response := &qualitygates.SearchResponse{}
+	if err := json.Unmarshal([]byte(`{"results": [{"key": "a", "selected": true}, {"key": "b", "selected": true}]}`), response); err != nil {
+		t.Fatalf("could not decode response: %+v", err)
+	}
+
+	tests := []struct {
+		name   string
+		keys   []attr.Value
+		want   []string
+		wantOk bool
+	}{
+		{name: "all keys", keys: stringSet("b", "a").Elems, want: []string{"a", "b"}, wantOk: true},
+		{name: "some keys", keys: stringSet("a").Elems, want: []string{"a"}, wantOk: true},
+		{name: "missing key", keys: stringSet("a", "c").Elems, wantOk: false},
+		{name: "no keys", keys: stringSet().Elems, want: []string{}, wantOk: true},
+		{name: "unknown key", keys: []attr.Value{types.String{Unknown: true}}, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findSelection(response, tt.keys)
+			if ok != tt.wantOk {
+				t.Fatalf("expected ok to be %v, got: %v", tt.wantOk, ok)
+			}
+			if !ok {
+				return
+			}
+			if got.ProjectKeys.Null || got.ProjectKeys.Unknown {
+				t.Fatalf("expected known project keys, got: %v", got.ProjectKeys)
+			}
+			if keys := stringValues(got.ProjectKeys.Elems); !reflect.DeepEqual(keys, tt.want) {
+				t.Errorf("expected project keys %v, got: %v", tt.want, keys)
+			}
+		})
+	}
+}
+
+func TestFindWebhook(t *testing.T) {
+	response := &webhooks.ListResponse{}
+	if err := json.Unmarshal([]byte(`{"webhooks": [{"key": "AX1", "name": "test", "url": "https://example.com", "secret": "s3cr3t"}]}`), response); err != nil {
+		t.Fatalf("could not decode response: %+v", err)
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		projectKey  string
+		wantOk      bool
+		wantProject types.String
+	}{
+		{name: "organization webhook", key: "AX1", wantOk: true, wantProject: types.String{Null: true}},
+		{name: "project webhook", key: "AX1", projectKey: "my-project", wantOk: true, wantProject: types.String{Value: "my-project"}},
+		{name: "missing webhook", key: "AX2", wantOk: false},
+		{name: "empty key", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findWebhook(response, tt.key, tt.projectKey)
+			if ok != tt.wantOk {
+				t.Fatalf("expected ok to be %v, got: %v", tt.wantOk, ok)
+			}
+			if !ok {
+				return
+			}
+			want := Webhook{
+				ID:      types.String{Value: "AX1"},
+				Key:     types.String{Value: "AX1"},
+				Project: tt.wantProject,
+				Name:    types.String{Value: "test"},
+				Secret:  types.String{Value: "s3cr3t"},
+				Url:     types.String{Value: "https://example.com"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %+v, got: %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestChangedAttrs(t *testing.T) {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"name":        {Type: types.StringType, Required: true},
+			"description": {Type: types.StringType, Optional: true},
+			"tags":        {Type: types.SetType{ElemType: types.StringType}, Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":        tftypes.String,
+		"description": tftypes.String,
+		"tags":        tftypes.Set{ElementType: tftypes.String},
+	}}
+	value := func(name string, description interface{}, tags ...string) tftypes.Value {
+		var tagsValue tftypes.Value
+		if tags == nil {
+			tagsValue = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+		} else {
+			elems := make([]tftypes.Value, len(tags))
+			for i, tag := range tags {
+				elems[i] = tftypes.NewValue(tftypes.String, tag)
+			}
+			tagsValue = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, name),
+			"description": tftypes.NewValue(tftypes.String, description),
+			"tags":        tagsValue,
+		})
+	}
+
+	tests := []struct {
+		name  string
+		state tftypes.Value
+		plan  tftypes.Value
+		want  []string
+	}{
+		{
+			name:  "no changes",
+			state: value("a", "b", "x"),
+			plan:  value("a", "b", "x"),
+			want:  []string{},
+		},
+		{
+			name:  "changed attributes",
+			state: value("a", "b"),
+			plan:  value("c", "d"),
+			want:  []string{"description", "name"},
+		},
+		{
+			name:  "from null",
+			state: value("a", nil),
+			plan:  value("a", "b"),
+			want:  []string{"description"},
+		},
+		{
+			name:  "to null",
+			state: value("a", "b", "x"),
+			plan:  value("a", nil),
+			want:  []string{"description", "tags"},
+		},
+		{
+			name:  "changed set element",
+			state: value("a", "b", "x", "y"),
+			plan:  value("a", "b", "x", "z"),
+			want:  []string{"tags"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tfsdk.UpdateResourceRequest{
+				State: tfsdk.State{Schema: schema, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: schema, Raw: tt.plan},
+			}
+
+			changed, diags := changedAttrs(req)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got := make([]string, 0, len(changed))
+			for name := range changed {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected changed attributes %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChangedAttrsDiagnostics(t *testing.T) {
+	req := tfsdk.UpdateResourceRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.String, "a")},
+		Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Number, 1)},
+	}
+
+	if _, diags := changedAttrs(req); !diags.HasError() {
+		t.Errorf("expected an error when the plan and state can not be compared")
+	}
+}
+
+func TestTerraformListString(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []string
+		want  string
+	}{
+		{name: "empty", items: []string{}, want: `[]`},
+		{name: "nil", want: `[]`},
+		{name: "one item", items: []string{"a"}, want: `["a"]`},
+		{name: "items", items: []string{"a", "b"}, want: `["a","b"]`},
+		{name: "empty item", items: []string{""}, want: `[""]`},
+		{name: "quotes", items: []string{`a"b`}, want: `["a\"b"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := terraformListString(tt.items); got != tt.want {
+				t.Errorf("expected %s, got: %s", tt.want, got)
+			}
+		})
+	}
+}
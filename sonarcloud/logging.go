@@ -0,0 +1,173 @@
+package sonarcloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveParams are the parts of parameter names of which the values are never logged
+var sensitiveParams = []string{
+	"secret",
+	"token",
+	"password",
+	"credential",
+}
+
+// loggingTransport logs every request to the API with tflog, so that they show up in the Terraform logs when running
+// with TF_LOG=DEBUG. The values of sensitive parameters are masked, and headers are never logged.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+// newLoggingTransport returns a transport that logs all requests that it sends using base
+func newLoggingTransport(base http.RoundTripper) *loggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &loggingTransport{base: base}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := requestFields(req)
+	tflog.Trace(ctx, "Sending SonarCloud API request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Warn(ctx, "SonarCloud API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	if resp.StatusCode >= 300 {
+		tflog.Warn(ctx, "SonarCloud API request returned an unsuccessful status", fields)
+	} else {
+		tflog.Debug(ctx, "SonarCloud API request", fields)
+	}
+	return resp, nil
+}
+
+// requestFields returns the log fields that describe the request
+func requestFields(req *http.Request) map[string]interface{} {
+	fields := map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+		"params":   requestParams(req),
+	}
+	if attempt := requestAttempt(req.Context()); attempt > 1 {
+		fields["attempt"] = attempt
+	}
+	return fields
+}
+
+// requestParams returns the query and form parameters of the request, with the values of sensitive parameters masked
+func requestParams(req *http.Request) string {
+	values := req.URL.Query()
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && req.GetBody != nil {
+		// Read a copy of the body, so that the request itself is left as is
+		if body, err := req.GetBody(); err == nil {
+			content, err := io.ReadAll(body)
+			_ = body.Close()
+			if form, parseErr := url.ParseQuery(string(content)); err == nil && parseErr == nil {
+				for key, v := range form {
+					values[key] = append(values[key], v...)
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// The parameters are logged as a single string rather than a map, as tflog only masks top-level string fields
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := strings.Join(values[key], ",")
+		if isSensitiveParam(key) {
+			value = "***"
+		}
+		params = append(params, key+"="+value)
+	}
+	return strings.Join(params, "&")
+}
+
+// isSensitiveParam reports whether the value of the parameter must not be logged
+func isSensitiveParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range sensitiveParams {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+type requestAttemptKey struct{}
+
+// withRequestAttempt returns a context that records which attempt of a request it belongs to
+func withRequestAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, requestAttemptKey{}, attempt)
+}
+
+// requestAttempt returns the attempt of the request that the context belongs to, starting at 1
+func requestAttempt(ctx context.Context) int {
+	if attempt, ok := ctx.Value(requestAttemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+// withRequestContext binds all requests of the data sources of the given type to the context of their read, so that
+// the requests are logged along with the read that sent them
+func withRequestContext(dataSourceType tfsdk.DataSourceType) tfsdk.DataSourceType {
+	return requestContextDataSourceType{DataSourceType: dataSourceType}
+}
+
+type requestContextDataSourceType struct {
+	tfsdk.DataSourceType
+}
+
+func (t requestContextDataSourceType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return requestContextDataSource{
+		dataSourceType: t.DataSourceType,
+		p:              p.(*provider),
+	}, nil
+}
+
+type requestContextDataSource struct {
+	dataSourceType tfsdk.DataSourceType
+	p              *provider
+}
+
+func (d requestContextDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	p := *d.p
+	if p.configured {
+		p.client = p.clientWithContext(ctx)
+	}
+
+	dataSource, diags := d.dataSourceType.NewDataSource(ctx, &p)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataSource.Read(ctx, req, resp)
+}
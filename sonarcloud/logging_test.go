@@ -0,0 +1,97 @@
+package sonarcloud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/webhooks"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"webhook":{"key":"webhook-key","name":"test","url":"https://example.com"}}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+	if err != nil {
+		t.Fatalf("could not create http client: %+v", err)
+	}
+	p := &provider{organization: "my-org", token: "my-token", apiHTTPClient: httpClient}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	_, err = p.clientWithContext(ctx).Webhooks.Create(webhooks.CreateRequest{
+		Name:         "test",
+		Organization: "my-org",
+		Secret:       "my-secret",
+		Url:          "https://example.com/my-token",
+	})
+	if err != nil {
+		t.Fatalf("request to the fake API failed: %+v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("could not decode the logs: %+v", err)
+	}
+
+	var entry map[string]interface{}
+	for _, e := range entries {
+		if e["@message"] == "SonarCloud API request" {
+			entry = e
+		}
+	}
+	if entry == nil {
+		t.Fatalf("expected the request to be logged, got: %v", entries)
+	}
+
+	if entry["method"] != http.MethodPost {
+		t.Errorf("expected method %q, got: %v", http.MethodPost, entry["method"])
+	}
+	if want := server.URL + "/api/webhooks/create"; entry["endpoint"] != want {
+		t.Errorf("expected endpoint %q, got: %v", want, entry["endpoint"])
+	}
+	if entry["status"] != float64(http.StatusOK) {
+		t.Errorf("expected status %d, got: %v", http.StatusOK, entry["status"])
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Errorf("expected the duration of the request to be logged")
+	}
+
+	want := "name=test&organization=my-org&secret=***&url=https://example.com/***"
+	if entry["params"] != want {
+		t.Errorf("expected params %q, got: %v", want, entry["params"])
+	}
+	if bytes.Contains(output.Bytes(), []byte("my-secret")) || bytes.Contains(output.Bytes(), []byte("my-token")) {
+		t.Errorf("expected no secrets in the logs, got: %s", output.String())
+	}
+}
+
+func TestIsSensitiveParam(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "secret", want: true},
+		{name: "clientSecret", want: true},
+		{name: "token", want: true},
+		{name: "password", want: true},
+		{name: "name", want: false},
+		{name: "organization", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSensitiveParam(tt.name); got != tt.want {
+				t.Errorf("isSensitiveParam(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,24 @@ type GroupMember struct {
 	Login types.String `tfsdk:"login"`
 }
 
+type OrganizationMember struct {
+	ID    types.String `tfsdk:"id"`
+	Login types.String `tfsdk:"login"`
+	Name  types.String `tfsdk:"name"`
+}
+
+type OrganizationMembers struct {
+	ID    types.String `tfsdk:"id"`
+	Query types.String `tfsdk:"query"`
+	Users []User       `tfsdk:"users"`
+}
+
+type GroupMembers struct {
+	ID     types.String `tfsdk:"id"`
+	Group  types.String `tfsdk:"group"`
+	Logins types.Set    `tfsdk:"logins"`
+}
+
 type User struct {
 	Login types.String `tfsdk:"login"`
 	Name  types.String `tfsdk:"name"`
@@ -40,15 +58,89 @@ type Token struct {
 }
 
 type Projects struct {
-	ID       types.String `tfsdk:"id"`
-	Projects []Project    `tfsdk:"projects"`
+	ID                types.String  `tfsdk:"id"`
+	Query             types.String  `tfsdk:"query"`
+	Keys              types.Set     `tfsdk:"keys"`
+	Visibility        types.String  `tfsdk:"visibility"`
+	AnalyzedBefore    types.String  `tfsdk:"analyzed_before"`
+	OnProvisionedOnly types.Bool    `tfsdk:"on_provisioned_only"`
+	Tags              types.Set     `tfsdk:"tags"`
+	Projects          []DataProject `tfsdk:"projects"`
 }
 
-type Project struct {
+type DataProject struct {
 	ID         types.String `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	Key        types.String `tfsdk:"key"`
 	Visibility types.String `tfsdk:"visibility"`
+	Tags       types.Set    `tfsdk:"tags"`
+}
+
+type Project struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Key               types.String `tfsdk:"key"`
+	Visibility        types.String `tfsdk:"visibility"`
+	AutomaticAnalysis types.Bool   `tfsdk:"automatic_analysis"`
+}
+
+type ProjectAzureBinding struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectKey       types.String `tfsdk:"project_key"`
+	AzureProjectName types.String `tfsdk:"azure_project_name"`
+	RepositoryName   types.String `tfsdk:"repository_name"`
+	Monorepo         types.Bool   `tfsdk:"monorepo"`
+}
+
+type ProjectBitbucketCloudBinding struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Repository types.String `tfsdk:"repository"`
+	Monorepo   types.Bool   `tfsdk:"monorepo"`
+}
+
+type ProjectGithubBinding struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectKey            types.String `tfsdk:"project_key"`
+	Repository            types.String `tfsdk:"repository"`
+	Monorepo              types.Bool   `tfsdk:"monorepo"`
+	SummaryCommentEnabled types.Bool   `tfsdk:"summary_comment_enabled"`
+}
+
+type ProjectGitlabBinding struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Repository types.String `tfsdk:"repository"`
+	Monorepo   types.Bool   `tfsdk:"monorepo"`
+}
+
+type ProjectTags struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Tags       types.Set    `tfsdk:"tags"`
+}
+
+type ProjectSetting struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectKey  types.String `tfsdk:"project_key"`
+	Key         types.String `tfsdk:"key"`
+	Value       types.String `tfsdk:"value"`
+	Values      types.List   `tfsdk:"values"`
+	FieldValues types.List   `tfsdk:"field_values"`
+}
+
+type ProjectNewCodePeriod struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Branch     types.String `tfsdk:"branch"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+}
+
+type DefaultNewCodePeriod struct {
+	ID    types.String `tfsdk:"id"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
 }
 
 type ProjectMainBranch struct {
@@ -78,11 +170,43 @@ type QualityGate struct {
 	Name       types.String  `tfsdk:"name"`
 }
 
+type DefaultQualityGate struct {
+	ID        types.String `tfsdk:"id"`
+	GateId    types.String `tfsdk:"gate_id"`
+	Name      types.String `tfsdk:"name"`
+	IsBuiltIn types.Bool   `tfsdk:"is_built_in"`
+}
+
 type QualityGates struct {
 	ID           types.String  `tfsdk:"id"`
 	QualityGates []QualityGate `tfsdk:"quality_gates"`
 }
 
+type QualityProfile struct {
+	ID        types.String         `tfsdk:"id"`
+	Key       types.String         `tfsdk:"key"`
+	Name      types.String         `tfsdk:"name"`
+	Language  types.String         `tfsdk:"language"`
+	Parent    types.String         `tfsdk:"parent"`
+	IsBuiltIn types.Bool           `tfsdk:"is_built_in"`
+	IsDefault types.Bool           `tfsdk:"is_default"`
+	Rules     []QualityProfileRule `tfsdk:"rules"`
+}
+
+type QualityProfileRule struct {
+	Rule     types.String `tfsdk:"rule"`
+	Severity types.String `tfsdk:"severity"`
+	Params   types.Map    `tfsdk:"params"`
+}
+
+type QualityProfileSelection struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Language    types.String `tfsdk:"language"`
+	ProfileKey  types.String `tfsdk:"profile_key"`
+	ProjectKeys types.Set    `tfsdk:"project_keys"`
+}
+
 type Selection struct {
 	ID          types.String `tfsdk:"id"`
 	GateId      types.String `tfsdk:"gate_id"`
@@ -123,6 +247,58 @@ type DataUserPermissions struct {
 	Users      []DataUserPermissionsUser `tfsdk:"users"`
 }
 
+type Permissions struct {
+	ID          types.String                  `tfsdk:"id"`
+	ProjectKey  types.String                  `tfsdk:"project_key"`
+	Permissions map[string]PermissionGrantees `tfsdk:"permissions"`
+}
+
+type PermissionGrantees struct {
+	Groups types.Set `tfsdk:"groups"`
+	Users  types.Set `tfsdk:"users"`
+}
+
+type PermissionTemplate struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	ProjectKeyPattern types.String `tfsdk:"project_key_pattern"`
+}
+
+type PermissionTemplateApplication struct {
+	ID          types.String `tfsdk:"id"`
+	TemplateID  types.String `tfsdk:"template_id"`
+	ProjectKeys types.Set    `tfsdk:"project_keys"`
+	Query       types.String `tfsdk:"query"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+}
+
+type PermissionTemplateGroupPermissions struct {
+	ID          types.String `tfsdk:"id"`
+	TemplateID  types.String `tfsdk:"template_id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+type PermissionTemplateUserPermissions struct {
+	ID          types.String `tfsdk:"id"`
+	TemplateID  types.String `tfsdk:"template_id"`
+	Login       types.String `tfsdk:"login"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+type PermissionTemplateProjectCreatorPermissions struct {
+	ID          types.String `tfsdk:"id"`
+	TemplateID  types.String `tfsdk:"template_id"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+type DefaultPermissionTemplate struct {
+	ID         types.String `tfsdk:"id"`
+	TemplateID types.String `tfsdk:"template_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
 type UserPermissions struct {
 	ID          types.String `tfsdk:"id"`
 	ProjectKey  types.String `tfsdk:"project_key"`
@@ -0,0 +1,144 @@
+package sonarcloud
+
+import (
+	"strings"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// PermissionTemplatesSearchResponse is used instead of the client library, because its SearchTemplates does not return a response
+type PermissionTemplatesSearchResponse struct {
+	PermissionTemplates []PermissionTemplatesSearchResponseTemplate `json:"permissionTemplates,omitempty"`
+	DefaultTemplates    []struct {
+		TemplateId string `json:"templateId,omitempty"`
+		Qualifier  string `json:"qualifier,omitempty"`
+	} `json:"defaultTemplates,omitempty"`
+}
+
+type PermissionTemplatesSearchResponseTemplate struct {
+	Id                string `json:"id,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Description       string `json:"description,omitempty"`
+	ProjectKeyPattern string `json:"projectKeyPattern,omitempty"`
+	Permissions       []struct {
+		Key                string `json:"key,omitempty"`
+		WithProjectCreator bool   `json:"withProjectCreator,omitempty"`
+	} `json:"permissions,omitempty"`
+}
+
+// PermissionTemplateUpdateRequest is used instead of permissions.UpdateTemplateRequest, because that one leaves out an
+// empty description and project key pattern, so they could never be cleared
+type PermissionTemplateUpdateRequest struct {
+	Description       string `form:"description"`
+	Id                string `form:"id,omitempty"`
+	Name              string `form:"name,omitempty"`
+	ProjectKeyPattern string `form:"projectKeyPattern"`
+}
+
+type PermissionTemplateGroupsRequest struct {
+	TemplateId string
+	Q          string
+}
+
+type PermissionTemplateGroupsResponseGroup struct {
+	Id          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+type PermissionTemplateUsersRequest struct {
+	TemplateId string
+	Q          string
+}
+
+type PermissionTemplateUsersResponseUser struct {
+	Login       string   `json:"login,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// searchPermissionTemplates returns the permission templates of the organization
+func searchPermissionTemplates(client *sonarcloud.Client) (*PermissionTemplatesSearchResponse, error) {
+	return getWithResponse[PermissionTemplatesSearchResponse](client, "/permissions/search_templates")
+}
+
+// findPermissionTemplate returns the permission template with the given ID if it exists in the response
+func findPermissionTemplate(response *PermissionTemplatesSearchResponse, id string) (*PermissionTemplatesSearchResponseTemplate, bool) {
+	for _, t := range response.PermissionTemplates {
+		if t.Id == id {
+			return &t, true
+		}
+	}
+	return nil, false
+}
+
+// findPermissionTemplateByName returns the permission template with the given name if it exists in the response
+func findPermissionTemplateByName(response *PermissionTemplatesSearchResponse, name string) (*PermissionTemplatesSearchResponseTemplate, bool) {
+	for _, t := range response.PermissionTemplates {
+		if t.Name == name {
+			return &t, true
+		}
+	}
+	return nil, false
+}
+
+// findDefaultPermissionTemplate returns the ID of the default permission template for projects if it exists in the response
+func findDefaultPermissionTemplate(response *PermissionTemplatesSearchResponse) (string, bool) {
+	for _, d := range response.DefaultTemplates {
+		if d.Qualifier == "TRK" {
+			return d.TemplateId, true
+		}
+	}
+	return "", false
+}
+
+// minSearchQueryLength is the minimum length of the q parameter of the permission searches, shorter values are rejected
+const minSearchQueryLength = 3
+
+// readPermissionTemplateGroup returns the permissions of the group with the given name in the permission template
+func readPermissionTemplateGroup(client *sonarcloud.Client, templateId, name string) (*PermissionTemplateGroupsResponseGroup, bool, error) {
+	request := PermissionTemplateGroupsRequest{TemplateId: templateId}
+	if len(name) >= minSearchQueryLength {
+		request.Q = name
+	}
+	groups, err := sonarcloud.GetAll[PermissionTemplateGroupsRequest, PermissionTemplateGroupsResponseGroup](client, "/permissions/template_groups", request, "groups")
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, g := range groups {
+		if permissionGroupNameEquals(g.Name, name) && len(g.Permissions) > 0 {
+			return &g, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// readPermissionTemplateUser returns the permissions of the user with the given login in the permission template
+func readPermissionTemplateUser(client *sonarcloud.Client, templateId, login string) (*PermissionTemplateUsersResponseUser, bool, error) {
+	request := PermissionTemplateUsersRequest{TemplateId: templateId}
+	if len(login) >= minSearchQueryLength {
+		request.Q = login
+	}
+	users, err := sonarcloud.GetAll[PermissionTemplateUsersRequest, PermissionTemplateUsersResponseUser](client, "/permissions/template_users", request, "users")
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, u := range users {
+		if u.Login == login && len(u.Permissions) > 0 {
+			return &u, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// permissionGroupNameEquals returns true if the group names are equal. The API returns the group of all users as
+// "Anyone", but accepts any casing of it, so that name is compared case-insensitively.
+func permissionGroupNameEquals(a, b string) bool {
+	if strings.EqualFold(a, "anyone") {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
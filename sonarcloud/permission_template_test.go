@@ -0,0 +1,88 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+func TestReadPermissionTemplateGroup(t *testing.T) {
+	tests := []struct {
+		name   string
+		group  string
+		wantQ  string
+		wantOk bool
+	}{
+		{name: "exact name", group: "developers", wantQ: "developers", wantOk: true},
+		{name: "anyone in lower case", group: "anyone", wantQ: "anyone", wantOk: true},
+		{name: "short name", group: "qa", wantOk: true},
+		{name: "other casing", group: "Developers", wantQ: "Developers"},
+		{name: "unknown", group: "operators", wantQ: "operators"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/permissions/template_groups" || r.URL.Query().Get("templateId") != "my-template" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				if q, ok := r.URL.Query()["q"]; ok && (q[0] != tt.wantQ || tt.wantQ == "") {
+					t.Errorf("expected q %q, got: %q", tt.wantQ, q[0])
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"paging":{"pageIndex":1,"pageSize":100,"total":3},"groups":[` +
+					`{"name":"Anyone","permissions":["user"]},` +
+					`{"name":"developers","permissions":["codeviewer","user"]},` +
+					`{"name":"qa","permissions":["issueadmin"]}]}`))
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+			if err != nil {
+				t.Fatalf("could not create http client: %+v", err)
+			}
+			client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+			_, ok, err := readPermissionTemplateGroup(client, "my-template", tt.group)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("expected ok: %t, got: %t", tt.wantOk, ok)
+			}
+		})
+	}
+}
+
+func TestPermissionTemplateUpdateRequest(t *testing.T) {
+	var got url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("could not parse form: %+v", err)
+		}
+		got = r.PostForm
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+	if err != nil {
+		t.Fatalf("could not create http client: %+v", err)
+	}
+	client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+	request := PermissionTemplateUpdateRequest{Id: "my-template", Name: "My template"}
+	if err := sonarcloud.Post(client, "/permissions/update_template", request); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// Empty values must be sent, otherwise the description and pattern cannot be cleared
+	for _, key := range []string{"description", "projectKeyPattern"} {
+		if values, ok := got[key]; !ok || values[0] != "" {
+			t.Errorf("expected an empty %s to be sent, got: %v", key, got)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package sonarcloud
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+// globalPermissions are the permissions that can be granted on the organization
+var globalPermissions = []string{
+	"admin",
+	"profileadmin",
+	"gateadmin",
+	"scan",
+	"provisioning",
+}
+
+// projectPermissions are the permissions that can be granted on a project, and thus in a permission template
+var projectPermissions = []string{
+	"admin",
+	"codeviewer",
+	"issueadmin",
+	"securityhotspotadmin",
+	"scan",
+	"user",
+}
+
+// allPermissions returns the permissions that can be granted on either the organization or a project
+func allPermissions() []string {
+	seen := make(map[string]struct{})
+	var all []string
+	for _, p := range append(append([]string{}, globalPermissions...), projectPermissions...) {
+		if _, ok := seen[p]; !ok {
+			seen[p] = struct{}{}
+			all = append(all, p)
+		}
+	}
+	return all
+}
+
+// permissionGrant is a single permission that is granted to either a group or a user
+type permissionGrant struct {
+	Permission string
+	Group      string
+	Login      string
+}
+
+// readPermissionGrants returns all permissions that are granted to groups and users on the organization, or on the
+// project if a key is given
+func readPermissionGrants(client *sonarcloud.Client, projectKey string) (map[permissionGrant]struct{}, error) {
+	grants := make(map[permissionGrant]struct{})
+
+	groups, err := sonarcloud.GetAll[UserGroupPermissionsSearchRequest, UserGroupPermissionsSearchResponseGroup](client, "/permissions/groups", UserGroupPermissionsSearchRequest{ProjectKey: projectKey}, "groups")
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		for _, p := range g.Permissions {
+			grants[permissionGrant{Permission: p, Group: g.Name}] = struct{}{}
+		}
+	}
+
+	users, err := sonarcloud.GetAll[UserPermissionsSearchRequest, UserPermissionsSearchResponseUser](client, "/permissions/users", UserPermissionsSearchRequest{ProjectKey: projectKey}, "users")
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		for _, p := range u.Permissions {
+			grants[permissionGrant{Permission: p, Login: u.Login}] = struct{}{}
+		}
+	}
+
+	return grants, nil
+}
+
+// permissionGrantsFrom returns the grants that are declared in a permission matrix
+func permissionGrantsFrom(matrix map[string]PermissionGrantees) map[permissionGrant]struct{} {
+	grants := make(map[permissionGrant]struct{})
+	for permission, grantees := range matrix {
+		for _, g := range grantees.Groups.Elems {
+			grants[permissionGrant{Permission: permission, Group: g.(types.String).Value}] = struct{}{}
+		}
+		for _, u := range grantees.Users.Elems {
+			grants[permissionGrant{Permission: permission, Login: u.(types.String).Value}] = struct{}{}
+		}
+	}
+	return grants
+}
+
+// permissionMatrixFrom returns the permission matrix of the given grants. The permissions of the prior matrix are
+// always included, and empty sets are only returned as null if they were null in the prior matrix, so that reading
+// back an applied matrix does not produce a diff.
+func permissionMatrixFrom(grants map[permissionGrant]struct{}, prior map[string]PermissionGrantees) map[string]PermissionGrantees {
+	groups := make(map[string][]string)
+	users := make(map[string][]string)
+	for permission := range prior {
+		groups[permission] = nil
+		users[permission] = nil
+	}
+	for grant := range grants {
+		if grant.Group != "" {
+			groups[grant.Permission] = append(groups[grant.Permission], grant.Group)
+		} else {
+			users[grant.Permission] = append(users[grant.Permission], grant.Login)
+		}
+		if _, ok := users[grant.Permission]; !ok {
+			users[grant.Permission] = nil
+		}
+		if _, ok := groups[grant.Permission]; !ok {
+			groups[grant.Permission] = nil
+		}
+	}
+
+	matrix := make(map[string]PermissionGrantees, len(groups))
+	for permission := range groups {
+		priorGrantees, ok := prior[permission]
+		if !ok {
+			priorGrantees = PermissionGrantees{Groups: types.Set{Null: true}, Users: types.Set{Null: true}}
+		}
+		matrix[permission] = PermissionGrantees{
+			Groups: granteesSet(groups[permission], priorGrantees.Groups),
+			Users:  granteesSet(users[permission], priorGrantees.Users),
+		}
+	}
+	return matrix
+}
+
+// granteesSet returns the given names as a sorted set, or as null if there are none and the prior set was null
+func granteesSet(names []string, prior types.Set) types.Set {
+	if len(names) == 0 && prior.Null {
+		return types.Set{ElemType: types.StringType, Null: true}
+	}
+
+	sort.Strings(names)
+	elems := make([]attr.Value, len(names))
+	for i, name := range names {
+		elems[i] = types.String{Value: name}
+	}
+	return types.Set{ElemType: types.StringType, Elems: elems}
+}
+
+// diffPermissionGrants returns the grants that need to be added and removed to get from the grants we have, to the
+// grants we want. The results are sorted to make the order of the requests predictable.
+func diffPermissionGrants(haves, wants map[permissionGrant]struct{}) (toAdd, toRemove []permissionGrant) {
+	for want := range wants {
+		if _, ok := haves[want]; !ok {
+			toAdd = append(toAdd, want)
+		}
+	}
+	for have := range haves {
+		if _, ok := wants[have]; !ok {
+			toRemove = append(toRemove, have)
+		}
+	}
+	sortPermissionGrants(toAdd)
+	sortPermissionGrants(toRemove)
+	return toAdd, toRemove
+}
+
+func sortPermissionGrants(grants []permissionGrant) {
+	sort.Slice(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		if a.Permission != b.Permission {
+			return a.Permission < b.Permission
+		}
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		return a.Login < b.Login
+	})
+}
+
+// addPermissionGrant grants the permission to the group or user of the grant
+func addPermissionGrant(client *sonarcloud.Client, organization, projectKey string, grant permissionGrant) error {
+	if grant.Group != "" {
+		return client.Permissions.AddGroup(permissions.AddGroupRequest{
+			GroupName:    grant.Group,
+			Organization: organization,
+			Permission:   grant.Permission,
+			ProjectKey:   projectKey,
+		})
+	}
+	return client.Permissions.AddUser(permissions.AddUserRequest{
+		Login:        grant.Login,
+		Organization: organization,
+		Permission:   grant.Permission,
+		ProjectKey:   projectKey,
+	})
+}
+
+// removePermissionGrant revokes the permission from the group or user of the grant
+func removePermissionGrant(client *sonarcloud.Client, organization, projectKey string, grant permissionGrant) error {
+	if grant.Group != "" {
+		return client.Permissions.RemoveGroup(permissions.RemoveGroupRequest{
+			GroupName:    grant.Group,
+			Organization: organization,
+			Permission:   grant.Permission,
+			ProjectKey:   projectKey,
+		})
+	}
+	return client.Permissions.RemoveUser(permissions.RemoveUserRequest{
+		Login:        grant.Login,
+		Organization: organization,
+		Permission:   grant.Permission,
+		ProjectKey:   projectKey,
+	})
+}
+
+// String returns a description of the grant for use in diagnostics
+func (g permissionGrant) String() string {
+	if g.Group != "" {
+		return "'" + g.Permission + "' of group '" + g.Group + "'"
+	}
+	return "'" + g.Permission + "' of user '" + g.Login + "'"
+}
@@ -0,0 +1,95 @@
+package sonarcloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffPermissionGrants(t *testing.T) {
+	haves := map[permissionGrant]struct{}{
+		{Permission: "admin", Group: "Owners"}:  {},
+		{Permission: "admin", Login: "manual"}:  {},
+		{Permission: "scan", Group: "Members"}:  {},
+		{Permission: "issueadmin", Login: "me"}: {},
+	}
+	wants := map[permissionGrant]struct{}{
+		{Permission: "admin", Group: "Owners"}:  {},
+		{Permission: "scan", Group: "Members"}:  {},
+		{Permission: "scan", Group: "Owners"}:   {},
+		{Permission: "codeviewer", Login: "me"}: {},
+	}
+
+	toAdd, toRemove := diffPermissionGrants(haves, wants)
+
+	expectedAdd := []permissionGrant{{Permission: "codeviewer", Login: "me"}, {Permission: "scan", Group: "Owners"}}
+	if !reflect.DeepEqual(toAdd, expectedAdd) {
+		t.Errorf("expected to add %v, got: %v", expectedAdd, toAdd)
+	}
+	expectedRemove := []permissionGrant{{Permission: "admin", Login: "manual"}, {Permission: "issueadmin", Login: "me"}}
+	if !reflect.DeepEqual(toRemove, expectedRemove) {
+		t.Errorf("expected to remove %v, got: %v", expectedRemove, toRemove)
+	}
+}
+
+func TestPermissionMatrixFrom(t *testing.T) {
+	grants := map[permissionGrant]struct{}{
+		{Permission: "admin", Group: "Owners"}: {},
+		{Permission: "admin", Login: "manual"}: {},
+		{Permission: "scan", Group: "Members"}: {},
+		{Permission: "scan", Group: "Admins"}:  {},
+	}
+	prior := map[string]PermissionGrantees{
+		"admin": {
+			Groups: stringSet("Owners"),
+			Users:  types.Set{ElemType: types.StringType, Null: true},
+		},
+		"user": {
+			Groups: types.Set{ElemType: types.StringType, Null: true},
+			Users:  stringSet(),
+		},
+	}
+
+	matrix := permissionMatrixFrom(grants, prior)
+
+	expected := map[string]PermissionGrantees{
+		// The grant that was made outside of Terraform shows up, even though the prior users were null
+		"admin": {
+			Groups: stringSet("Owners"),
+			Users:  stringSet("manual"),
+		},
+		// Undeclared permissions show up with null sets for missing grantees
+		"scan": {
+			Groups: stringSet("Admins", "Members"),
+			Users:  types.Set{ElemType: types.StringType, Null: true},
+		},
+		// Declared permissions without grants keep their null and empty sets
+		"user": {
+			Groups: types.Set{ElemType: types.StringType, Null: true},
+			Users:  stringSet(),
+		},
+	}
+	if len(matrix) != len(expected) {
+		t.Fatalf("expected %d permissions, got: %v", len(expected), matrix)
+	}
+	for permission, want := range expected {
+		got, ok := matrix[permission]
+		if !ok {
+			t.Errorf("expected permission '%s' in matrix, got: %v", permission, matrix)
+			continue
+		}
+		if !got.Groups.Equal(want.Groups) || !got.Users.Equal(want.Users) {
+			t.Errorf("expected %v for permission '%s', got: %v", want, permission, got)
+		}
+	}
+}
+
+func stringSet(values ...string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.String{Value: v}
+	}
+	return types.Set{ElemType: types.StringType, Elems: elems}
+}
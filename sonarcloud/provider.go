@@ -2,22 +2,42 @@ package sonarcloud
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 )
 
 func New() tfsdk.Provider {
-	return &provider{}
+	return &provider{
+		singletons: newSingletonClaims(),
+	}
+}
+
+// NewWithHTTPClient returns a provider that uses the given client for all requests to the SonarCloud API.
+// This can be used to point the provider at a fake API in tests.
+func NewWithHTTPClient(client *http.Client) tfsdk.Provider {
+	return &provider{
+		httpClient: client,
+		singletons: newSingletonClaims(),
+	}
 }
 
 type provider struct {
-	configured   bool
-	client       *sonarcloud.Client
-	organization string
+	configured    bool
+	client        *sonarcloud.Client
+	httpClient    *http.Client
+	apiHTTPClient *http.Client
+	organization  string
+	token         string
+	singletons    *singletonClaims
 }
 
 func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -36,6 +56,48 @@ func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics)
 				Description: "The token of a user with admin permissions in the organization. This value must be set in" +
 					" the `SONARCLOUD_TOKEN` environment variable if left empty.",
 			},
+			"api_url": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The base URL of the SonarCloud API, e.g. `https://sonarcloud.io/api`. Use this to target a" +
+					" regional SonarCloud instance. This value can also be set in the `SONARCLOUD_API_URL` environment" +
+					" variable. Defaults to `" + sonarcloud.API + "`.",
+			},
+			"max_retries": {
+				Type:     types.Int64Type,
+				Optional: true,
+				Description: "The maximum number of times a request is retried when the API rate limits it (HTTP 429) or is" +
+					" temporarily unavailable (HTTP 502, 503 or 504). Unavailable APIs and failed connections are only retried for" +
+					" read requests, because a write request might have been processed already." +
+					" Defaults to `5`. Set to `0` to disable retries.",
+			},
+			"retry_wait_min": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The minimum time to wait before retrying a request, as a duration like `500ms` or `2s`." +
+					" The wait time grows exponentially with every retry, unless the API sends a `Retry-After` header. Defaults to `1s`.",
+				Validators: []tfsdk.AttributeValidator{
+					duration(),
+				},
+			},
+			"retry_wait_max": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The maximum time to wait before retrying a request, as a duration like `30s` or `1m`." +
+					" A `Retry-After` header of the API is honoured even if it is longer. Defaults to `30s`.",
+				Validators: []tfsdk.AttributeValidator{
+					duration(),
+				},
+			},
+			"request_timeout": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The time after which a single request to the API is aborted, as a duration like `30s` or `2m`." +
+					" Defaults to `1m`. Set to `0s` to disable the timeout.",
+				Validators: []tfsdk.AttributeValidator{
+					duration(),
+				},
+			},
 		},
 	}, nil
 }
@@ -77,30 +139,112 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		token = config.Token.Value
 	}
 
-	c := sonarcloud.NewClient(organization, token, nil)
+	var apiURL string
+	if config.ApiURL.Unknown {
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as api_url",
+		)
+		return
+	}
+
+	if config.ApiURL.Null {
+		apiURL = os.Getenv("SONARCLOUD_API_URL")
+	} else {
+		apiURL = config.ApiURL.Value
+	}
+	if apiURL == "" {
+		apiURL = sonarcloud.API
+	}
+
+	httpClient, err := newHTTPClient(p.httpClient, apiURL)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid API URL",
+			fmt.Sprintf("The API URL could not be used: %+v", err),
+		)
+		return
+	}
+
+	retry, diags := retryConfigFrom(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	httpClient = withReadCache(withRetries(httpClient, retry), newReadCache())
+
+	c := sonarcloud.NewClient(organization, token, httpClient)
 	p.client = c
+	p.apiHTTPClient = httpClient
 	p.organization = organization
+	p.token = token
+
+	// The API responds with a 404 to every request for an organization that does not exist, which would make it look
+	// as if all resources were deleted. Checking the organization once here keeps them from being removed from the state.
+	resp.Diagnostics.Append(p.checkOrganization(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	p.configured = true
+	p.singletons.reset()
+
+	tflog.Debug(ctx, "Configured SonarCloud client", map[string]interface{}{
+		"organization":    organization,
+		"api_url":         apiURL,
+		"max_retries":     retry.MaxRetries,
+		"retry_wait_min":  retry.WaitMin.String(),
+		"retry_wait_max":  retry.WaitMax.String(),
+		"request_timeout": retry.RequestTimeout.String(),
+	})
 }
 
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
-	return map[string]tfsdk.ResourceType{
-		"sonarcloud_user_group":             resourceUserGroupType{},
-		"sonarcloud_user_group_member":      resourceUserGroupMemberType{},
-		"sonarcloud_project":                resourceProjectType{},
-		"sonarcloud_project_link":           resourceProjectLinkType{},
-		"sonarcloud_project_main_branch":    resourceProjectMainBranchType{},
-		"sonarcloud_user_token":             resourceUserTokenType{},
-		"sonarcloud_quality_gate":           resourceQualityGateType{},
-		"sonarcloud_quality_gate_selection": resourceQualityGateSelectionType{},
-		"sonarcloud_user_permissions":       resourceUserPermissionsType{},
-		"sonarcloud_user_group_permissions": resourceUserGroupPermissionsType{},
-		"sonarcloud_webhook":                resourceWebhookType{},
-	}, nil
+	resources := map[string]tfsdk.ResourceType{
+		"sonarcloud_organization_member":                             resourceOrganizationMemberType{},
+		"sonarcloud_user_group":                                      resourceUserGroupType{},
+		"sonarcloud_user_group_member":                               resourceUserGroupMemberType{},
+		"sonarcloud_user_group_members":                              resourceUserGroupMembersType{},
+		"sonarcloud_project":                                         resourceProjectType{},
+		"sonarcloud_project_azure_binding":                           resourceProjectAzureBindingType{},
+		"sonarcloud_project_bitbucket_cloud_binding":                 resourceProjectBitbucketCloudBindingType{},
+		"sonarcloud_project_github_binding":                          resourceProjectGithubBindingType{},
+		"sonarcloud_project_gitlab_binding":                          resourceProjectGitlabBindingType{},
+		"sonarcloud_project_link":                                    resourceProjectLinkType{},
+		"sonarcloud_project_main_branch":                             resourceProjectMainBranchType{},
+		"sonarcloud_project_new_code_period":                         resourceProjectNewCodePeriodType{},
+		"sonarcloud_project_setting":                                 resourceProjectSettingType{},
+		"sonarcloud_project_tags":                                    resourceProjectTagsType{},
+		"sonarcloud_default_permission_template":                     resourceDefaultPermissionTemplateType{},
+		"sonarcloud_default_new_code_period":                         resourceDefaultNewCodePeriodType{},
+		"sonarcloud_default_quality_gate":                            resourceDefaultQualityGateType{},
+		"sonarcloud_user_token":                                      resourceUserTokenType{},
+		"sonarcloud_quality_gate":                                    resourceQualityGateType{},
+		"sonarcloud_quality_gate_selection":                          resourceQualityGateSelectionType{},
+		"sonarcloud_quality_profile":                                 resourceQualityProfileType{},
+		"sonarcloud_quality_profile_selection":                       resourceQualityProfileSelectionType{},
+		"sonarcloud_permissions":                                     resourcePermissionsType{},
+		"sonarcloud_permission_template":                             resourcePermissionTemplateType{},
+		"sonarcloud_permission_template_application":                 resourcePermissionTemplateApplicationType{},
+		"sonarcloud_permission_template_group_permissions":           resourcePermissionTemplateGroupPermissionsType{},
+		"sonarcloud_permission_template_project_creator_permissions": resourcePermissionTemplateProjectCreatorPermissionsType{},
+		"sonarcloud_permission_template_user_permissions":            resourcePermissionTemplateUserPermissionsType{},
+		"sonarcloud_user_permissions":                                resourceUserPermissionsType{},
+		"sonarcloud_user_group_permissions":                          resourceUserGroupPermissionsType{},
+		"sonarcloud_webhook":                                         resourceWebhookType{},
+	}
+
+	// Every resource gets a timeouts attribute, so that long running operations can be bounded
+	for name, resourceType := range resources {
+		resources[name] = withTimeouts(resourceType)
+	}
+	return resources, nil
 }
 
 func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
-	return map[string]tfsdk.DataSourceType{
+	dataSources := map[string]tfsdk.DataSourceType{
+		"sonarcloud_organization_members":   dataSourceOrganizationMembersType{},
 		"sonarcloud_projects":               dataSourceProjectsType{},
 		"sonarcloud_project_links":          dataSourceProjectLinksType{},
 		"sonarcloud_user_group":             dataSourceUserGroupType{},
@@ -111,10 +255,118 @@ func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourc
 		"sonarcloud_quality_gate":           dataSourceQualityGateType{},
 		"sonarcloud_quality_gates":          dataSourceQualityGatesType{},
 		"sonarcloud_webhooks":               dataSourceWebhooksType{},
-	}, nil
+	}
+
+	// The requests of every data source are bound to its read, so that they are logged along with it
+	for name, dataSourceType := range dataSources {
+		dataSources[name] = withRequestContext(dataSourceType)
+	}
+	return dataSources, nil
+}
+
+// clientWithContext returns a client that binds all of its requests to ctx, so that they are aborted once ctx is done
+// and logged along with the operation that sent them
+func (p *provider) clientWithContext(ctx context.Context) *sonarcloud.Client {
+	if p.token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, p.token)
+	}
+	return sonarcloud.NewClient(p.organization, p.token, withContext(ctx, p.apiHTTPClient))
+}
+
+// checkOrganization returns an error if the organization does not exist or is not visible to the user of the token
+func (p *provider) checkOrganization(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := getWithResponse[OrganizationsSearchResponse](p.clientWithContext(ctx), "/organizations/search",
+		"organizations", p.organization,
+	)
+	if err != nil {
+		diags.Append(apiErrorDiagnostic(
+			"Could not read the organization",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+			err,
+		))
+		return diags
+	}
+
+	for _, o := range response.Organizations {
+		if o.Key == p.organization {
+			return diags
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("organization"),
+		"Organization not found",
+		fmt.Sprintf("The organization %q does not exist, or is not visible to the user of the token.", p.organization),
+	)
+	return diags
+}
+
+type OrganizationsSearchResponse struct {
+	Organizations []struct {
+		Key  string `json:"key,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"organizations,omitempty"`
+}
+
+// retryConfigFrom returns the retry configuration of the provider, using the defaults for the attributes that are not set
+func retryConfigFrom(config providerData) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	retry := defaultRetryConfig()
+
+	if !config.MaxRetries.Null && !config.MaxRetries.Unknown {
+		if config.MaxRetries.Value < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				fmt.Sprintf("The maximum number of retries must not be negative, got: %d.", config.MaxRetries.Value),
+			)
+		}
+		retry.MaxRetries = int(config.MaxRetries.Value)
+	}
+
+	durations := []struct {
+		name   string
+		value  types.String
+		target *time.Duration
+	}{
+		{name: "retry_wait_min", value: config.RetryWaitMin, target: &retry.WaitMin},
+		{name: "retry_wait_max", value: config.RetryWaitMax, target: &retry.WaitMax},
+		{name: "request_timeout", value: config.RequestTimeout, target: &retry.RequestTimeout},
+	}
+	for _, d := range durations {
+		if d.value.Null || d.value.Unknown {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value.Value)
+		if err != nil || parsed < 0 {
+			diags.AddAttributeError(
+				path.Root(d.name),
+				"Invalid Duration",
+				fmt.Sprintf("The value must be a positive duration like `30s`, got: %q.", d.value.Value),
+			)
+			continue
+		}
+		*d.target = parsed
+	}
+
+	if retry.WaitMin > retry.WaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Wait Times",
+			fmt.Sprintf("The minimum wait time (%s) must not be longer than the maximum wait time (%s).", retry.WaitMin, retry.WaitMax),
+		)
+	}
+
+	return retry, diags
 }
 
 type providerData struct {
-	Organization types.String `tfsdk:"organization"`
-	Token        types.String `tfsdk:"token"`
+	Organization   types.String `tfsdk:"organization"`
+	Token          types.String `tfsdk:"token"`
+	ApiURL         types.String `tfsdk:"api_url"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin   types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax   types.String `tfsdk:"retry_wait_max"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
 }
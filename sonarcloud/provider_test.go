@@ -1,8 +1,12 @@
 package sonarcloud
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"terraform-provider-sonarcloud/internal/fakeapi"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -10,12 +14,33 @@ import (
 
 var testAccProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
+// testAccFakeAPI is the fake SonarCloud API that the acceptance tests run against when no token is set
+var testAccFakeAPI *fakeapi.Server
+
 func init() {
 	testAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"sonarcloud": providerserver.NewProtocol6WithError(New()),
 	}
 }
 
+// TestMain starts the fake SonarCloud API for acceptance tests without a SONARCLOUD_TOKEN, and points the provider and
+// the tests at its fixtures. The environment is set up before any test runs, because most tests read it right away.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("SONARCLOUD_TOKEN") == "" {
+		testAccFakeAPI = fakeapi.NewServer()
+		for key, value := range testAccFakeAPI.Env() {
+			os.Setenv(key, value)
+		}
+	}
+
+	code := m.Run()
+
+	if testAccFakeAPI != nil {
+		testAccFakeAPI.Close()
+	}
+	os.Exit(code)
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("SONARCLOUD_ORGANIZATION"); v == "" {
 		t.Fatal("SONARCLOUD_ORGANIZATION must be set for acceptance tests")
@@ -24,3 +49,63 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SONARCLOUD_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccSkipWithFakeAPI skips the test when it runs against the fake SonarCloud API, because the fake does not cover
+// what the test needs
+func testAccSkipWithFakeAPI(t *testing.T, reason string) {
+	if testAccFakeAPI != nil {
+		t.Skipf("Skipped against the fake SonarCloud API: %s", reason)
+	}
+}
+
+func TestCheckOrganization(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "exists",
+			status: http.StatusOK,
+			body:   `{"paging":{"pageIndex":1,"pageSize":100,"total":1},"organizations":[{"key":"my-org","name":"My org"}]}`,
+		},
+		{
+			name:    "does not exist",
+			status:  http.StatusOK,
+			body:    `{"paging":{"pageIndex":1,"pageSize":100,"total":0},"organizations":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			body:    `{"errors":[{"msg":"Authentication is required"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/organizations/search" || r.URL.Query().Get("organizations") != "my-org" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+			if err != nil {
+				t.Fatalf("could not create http client: %+v", err)
+			}
+			p := &provider{apiHTTPClient: httpClient, organization: "my-org", token: "token"}
+
+			diags := p.checkOrganization(context.Background())
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("expected error: %t, got: %v", tt.wantErr, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceDefaultNewCodePeriodType struct{}
+
+func (r resourceDefaultNewCodePeriodType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource manages the default new code definition of the organization, which is used by all
+projects that do not define their own new code definition.
+
+Note that only one instance of this resource should be declared per organization. Destroying it resets the
+default to the SonarCloud default.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the organization.",
+			},
+			"type": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The type of the new code definition. Must be one of `PREVIOUS_VERSION` or `NUMBER_OF_DAYS`.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("PREVIOUS_VERSION", "NUMBER_OF_DAYS"),
+				},
+			},
+			"value": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The number of days for `NUMBER_OF_DAYS`. Must not be set for `PREVIOUS_VERSION`.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceDefaultNewCodePeriodType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceDefaultNewCodePeriod{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceDefaultNewCodePeriod struct {
+	p provider
+}
+
+func (r resourceDefaultNewCodePeriod) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		newCodePeriod(),
+	}
+}
+
+func (r resourceDefaultNewCodePeriod) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan DefaultNewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodSetRequest{
+		Organization: r.p.organization,
+		Type:         plan.Type.Value,
+		Value:        plan.Value.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the default new code period",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = types.String{Value: r.p.organization}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultNewCodePeriod) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state DefaultNewCodePeriod
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := readNewCodePeriod(r.p.client, "", "")
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default new code period",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// An inherited new code period means that the organization falls back to the SonarCloud default
+	if response.Inherited {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := DefaultNewCodePeriod{
+		ID:    types.String{Value: r.p.organization},
+		Type:  types.String{Value: response.Type},
+		Value: newCodePeriodValue(response.Value),
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultNewCodePeriod) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan DefaultNewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodSetRequest{
+		Organization: r.p.organization,
+		Type:         plan.Type.Value,
+		Value:        plan.Value.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the default new code period",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = types.String{Value: r.p.organization}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultNewCodePeriod) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	request := NewCodePeriodUnsetRequest{
+		Organization: r.p.organization,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/unset", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not unset the default new code period",
+			fmt.Sprintf("The Unset request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceDefaultNewCodePeriod) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	if req.ID != r.p.organization {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the key of the configured organization (%s) as import identifier. Got: %q", r.p.organization, req.ID),
+		)
+		return
+	}
+
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,55 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccDefaultNewCodePeriod(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultNewCodePeriodConfig("NUMBER_OF_DAYS", `"30"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_new_code_period.test", "id", organization),
+					resource.TestCheckResourceAttr("sonarcloud_default_new_code_period.test", "type", "NUMBER_OF_DAYS"),
+					resource.TestCheckResourceAttr("sonarcloud_default_new_code_period.test", "value", "30"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_default_new_code_period.test",
+				ImportState:       true,
+				ImportStateId:     organization,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultNewCodePeriodConfig("PREVIOUS_VERSION", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_new_code_period.test", "type", "PREVIOUS_VERSION"),
+					resource.TestCheckNoResourceAttr("sonarcloud_default_new_code_period.test", "value"),
+				),
+			},
+		},
+		CheckDestroy: testAccDefaultNewCodePeriodDestroy,
+	})
+}
+
+func testAccDefaultNewCodePeriodDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccDefaultNewCodePeriodConfig(periodType, value string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_default_new_code_period" "test" {
+	type  = "%s"
+	value = %s
+}
+`, periodType, value)
+}
@@ -0,0 +1,235 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourceDefaultPermissionTemplateType struct{}
+
+func (r resourceDefaultPermissionTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource manages the default permission template for new projects of the organization.
+
+Only one instance of this resource may be declared per organization. An organization always has a default permission
+template, so destroying this resource only removes it from the state.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the organization.",
+			},
+			"template_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the permission template to use as default.",
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name of the default permission template.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceDefaultPermissionTemplateType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceDefaultPermissionTemplate{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceDefaultPermissionTemplate struct {
+	p provider
+}
+
+// ModifyPlan refuses to plan more than one default permission template at once, as they would overwrite each other
+func (r resourceDefaultPermissionTemplate) ModifyPlan(_ context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !r.p.singletons.claim("sonarcloud_default_permission_template") {
+		resp.Diagnostics.AddError(
+			"Conflicting default permission templates",
+			"The sonarcloud_default_permission_template resource is declared more than once. "+
+				"An organization can only have one default permission template, so declare this resource only once.",
+		)
+	}
+}
+
+func (r resourceDefaultPermissionTemplate) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan DefaultPermissionTemplate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := permissions.SetDefaultTemplateRequest{
+		Organization: r.p.organization,
+		Qualifier:    "TRK",
+		TemplateId:   plan.TemplateID.Value,
+	}
+	if err := r.p.client.Permissions.SetDefaultTemplate(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not set the default permission template",
+			fmt.Sprintf("The SetDefaultTemplate request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readDefaultPermissionTemplate(r.p.client, r.p.organization)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default permission template",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the default permission template",
+			fmt.Sprintf("The organization '%s' does not have a default permission template.", r.p.organization),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultPermissionTemplate) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state DefaultPermissionTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readDefaultPermissionTemplate(r.p.client, r.p.organization)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default permission template",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultPermissionTemplate) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan DefaultPermissionTemplate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := permissions.SetDefaultTemplateRequest{
+		Organization: r.p.organization,
+		Qualifier:    "TRK",
+		TemplateId:   plan.TemplateID.Value,
+	}
+	if err := r.p.client.Permissions.SetDefaultTemplate(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not set the default permission template",
+			fmt.Sprintf("The SetDefaultTemplate request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readDefaultPermissionTemplate(r.p.client, r.p.organization)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default permission template",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the default permission template",
+			fmt.Sprintf("The organization '%s' does not have a default permission template.", r.p.organization),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultPermissionTemplate) Delete(ctx context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The default template can not be unset, so it is left as is
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceDefaultPermissionTemplate) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	if req.ID != r.p.organization {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the key of the configured organization (%s) as import identifier. Got: %q", r.p.organization, req.ID),
+		)
+		return
+	}
+
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readDefaultPermissionTemplate returns the current default permission template for new projects of the organization
+func readDefaultPermissionTemplate(client *sonarcloud.Client, organization string) (DefaultPermissionTemplate, bool, error) {
+	response, err := searchPermissionTemplates(client)
+	if err != nil {
+		return DefaultPermissionTemplate{}, false, err
+	}
+
+	id, ok := findDefaultPermissionTemplate(response)
+	if !ok {
+		return DefaultPermissionTemplate{}, false, nil
+	}
+
+	template, ok := findPermissionTemplate(response, id)
+	if !ok {
+		return DefaultPermissionTemplate{}, false, nil
+	}
+
+	return DefaultPermissionTemplate{
+		ID:         types.String{Value: organization},
+		TemplateID: types.String{Value: template.Id},
+		Name:       types.String{Value: template.Name},
+	}, true, nil
+}
@@ -0,0 +1,66 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccDefaultPermissionTemplate(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+	templateId := os.Getenv("SONARCLOUD_PERMISSION_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if templateId == "" {
+				t.Skip("SONARCLOUD_PERMISSION_TEMPLATE_ID must be set to test the default permission template")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultPermissionTemplateConfig(templateId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_permission_template.test", "id", organization),
+					resource.TestCheckResourceAttr("sonarcloud_default_permission_template.test", "template_id", templateId),
+					resource.TestCheckResourceAttrSet("sonarcloud_default_permission_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_default_permission_template.test",
+				ImportState:       true,
+				ImportStateId:     organization,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccDefaultPermissionTemplateTwiceConfig(templateId),
+				ExpectError: regexp.MustCompile("Conflicting default permission templates"),
+			},
+		},
+		CheckDestroy: testAccDefaultPermissionTemplateDestroy,
+	})
+}
+
+func testAccDefaultPermissionTemplateDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccDefaultPermissionTemplateConfig(templateId string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_default_permission_template" "test" {
+	template_id = "%s"
+}
+`, templateId)
+}
+
+func testAccDefaultPermissionTemplateTwiceConfig(templateId string) string {
+	return testAccDefaultPermissionTemplateConfig(templateId) + fmt.Sprintf(`
+resource "sonarcloud_default_permission_template" "other" {
+	template_id = "%s"
+}
+`, templateId)
+}
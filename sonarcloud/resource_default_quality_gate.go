@@ -0,0 +1,259 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+type resourceDefaultQualityGateType struct{}
+
+func (r resourceDefaultQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource manages the default quality gate of the organization.
+
+Only one instance of this resource may be declared per organization. Destroying it resets the default to the
+built-in quality gate (Sonar way).`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the organization.",
+			},
+			"gate_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the quality gate to use as default.",
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name of the default quality gate.",
+			},
+			"is_built_in": {
+				Type:        types.BoolType,
+				Computed:    true,
+				Description: "Defines whether the default quality gate is built in.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceDefaultQualityGateType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceDefaultQualityGate{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceDefaultQualityGate struct {
+	p provider
+}
+
+// ModifyPlan refuses to plan more than one default quality gate at once, as they would overwrite each other
+func (r resourceDefaultQualityGate) ModifyPlan(_ context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !r.p.singletons.claim("sonarcloud_default_quality_gate") {
+		resp.Diagnostics.AddError(
+			"Conflicting default quality gates",
+			"The sonarcloud_default_quality_gate resource is declared more than once. "+
+				"An organization can only have one default quality gate, so declare this resource only once.",
+		)
+	}
+}
+
+func (r resourceDefaultQualityGate) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan DefaultQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := qualitygates.SetAsDefaultRequest{
+		Id:           plan.GateId.Value,
+		Organization: r.p.organization,
+	}
+	if err := r.p.client.Qualitygates.SetAsDefault(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not set the default quality gate",
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readDefaultQualityGate(r.p.client, r.p.organization)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default quality gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the default quality gate",
+			fmt.Sprintf("The organization '%s' does not have a default quality gate.", r.p.organization),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultQualityGate) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state DefaultQualityGate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readDefaultQualityGate(r.p.client, r.p.organization)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default quality gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the default quality gate",
+			fmt.Sprintf("The organization '%s' does not have a default quality gate.", r.p.organization),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan DefaultQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := qualitygates.SetAsDefaultRequest{
+		Id:           plan.GateId.Value,
+		Organization: r.p.organization,
+	}
+	if err := r.p.client.Qualitygates.SetAsDefault(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not set the default quality gate",
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readDefaultQualityGate(r.p.client, r.p.organization)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the default quality gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the default quality gate",
+			fmt.Sprintf("The organization '%s' does not have a default quality gate.", r.p.organization),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceDefaultQualityGate) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	response, err := r.p.client.Qualitygates.List(qualitygates.ListRequest{Organization: r.p.organization})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the quality gates",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	builtInId, ok := findBuiltInQualityGate(response)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the built-in quality gate",
+			"The default quality gate can not be reset, because no built-in quality gate was found.",
+		)
+		return
+	}
+
+	request := qualitygates.SetAsDefaultRequest{
+		Id:           builtInId,
+		Organization: r.p.organization,
+	}
+	if err := r.p.client.Qualitygates.SetAsDefault(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not reset the default quality gate",
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceDefaultQualityGate) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	if req.ID != r.p.organization {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the key of the configured organization (%s) as import identifier. Got: %q", r.p.organization, req.ID),
+		)
+		return
+	}
+
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readDefaultQualityGate returns the current default quality gate of the organization
+func readDefaultQualityGate(client *sonarcloud.Client, organization string) (DefaultQualityGate, bool, error) {
+	response, err := client.Qualitygates.List(qualitygates.ListRequest{Organization: organization})
+	if err != nil {
+		return DefaultQualityGate{}, false, err
+	}
+
+	result, ok := findDefaultQualityGate(response, organization)
+	return result, ok, nil
+}
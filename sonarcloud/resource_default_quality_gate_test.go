@@ -0,0 +1,65 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccDefaultQualityGate(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultQualityGateConfig("default_quality_gate_test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_quality_gate.test", "id", organization),
+					resource.TestCheckResourceAttrPair("sonarcloud_default_quality_gate.test", "gate_id", "sonarcloud_quality_gate.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_default_quality_gate.test", "name", "default_quality_gate_test"),
+					resource.TestCheckResourceAttr("sonarcloud_default_quality_gate.test", "is_built_in", "false"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_default_quality_gate.test",
+				ImportState:       true,
+				ImportStateId:     organization,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccDefaultQualityGateTwiceConfig("default_quality_gate_test"),
+				ExpectError: regexp.MustCompile("Conflicting default quality gates"),
+			},
+		},
+		CheckDestroy: testAccDefaultQualityGateDestroy,
+	})
+}
+
+func testAccDefaultQualityGateDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccDefaultQualityGateConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_default_quality_gate" "test" {
+	gate_id = sonarcloud_quality_gate.test.id
+}
+`, name)
+}
+
+func testAccDefaultQualityGateTwiceConfig(name string) string {
+	return testAccDefaultQualityGateConfig(name) + `
+resource "sonarcloud_default_quality_gate" "other" {
+	gate_id = sonarcloud_quality_gate.test.id
+}
+`
+}
@@ -0,0 +1,209 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceOrganizationMemberType struct{}
+
+func (r resourceOrganizationMemberType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a single member of the organization. " +
+			"A user must be a member of the organization before it can be added to groups or be granted permissions.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the login of the user.",
+			},
+			"login": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The login of the user that should be added to the organization.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(2, 255),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name of the user.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceOrganizationMemberType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceOrganizationMember{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceOrganizationMember struct {
+	p provider
+}
+
+func (r resourceOrganizationMember) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan OrganizationMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := OrganizationMemberRequest{
+		Login:        plan.Login.Value,
+		Organization: r.p.organization,
+	}
+	if err := sonarcloud.Post(r.p.client, "/organizations/add_member", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not add the organization member",
+			fmt.Sprintf("The AddMember request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readOrganizationMember(r.p.client, plan.Login.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the organization member",
+			fmt.Sprintf("The SearchMembers request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the organization member",
+			fmt.Sprintf("The user '%s' is not a member of the organization after adding it.", plan.Login.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceOrganizationMember) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state OrganizationMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readOrganizationMember(r.p.client, state.Login.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the organization member",
+			fmt.Sprintf("The SearchMembers request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceOrganizationMember) Update(_ context.Context, _ tfsdk.UpdateResourceRequest, _ *tfsdk.UpdateResourceResponse) {
+	// NOOP, we always need to recreate
+}
+
+func (r resourceOrganizationMember) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state OrganizationMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := OrganizationMemberRequest{
+		Login:        state.Login.Value,
+		Organization: r.p.organization,
+	}
+	if err := sonarcloud.Post(r.p.client, "/organizations/remove_member", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not remove the organization member",
+			fmt.Sprintf("The RemoveMember request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceOrganizationMember) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("login"), req, resp)
+}
+
+// OrganizationMemberRequest is used to add or remove a member, as the client library does not cover the organizations endpoints
+type OrganizationMemberRequest struct {
+	Login        string `form:"login,omitempty"`
+	Organization string `form:"organization,omitempty"`
+}
+
+type OrganizationSearchMembersRequest struct {
+	Q string
+}
+
+type OrganizationSearchMembersResponseUser struct {
+	Login string `json:"login,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// searchOrganizationMembers returns the members of the organization that match the query, or all members for an empty query
+func searchOrganizationMembers(client *sonarcloud.Client, query string) ([]OrganizationSearchMembersResponseUser, error) {
+	request := OrganizationSearchMembersRequest{Q: query}
+	return sonarcloud.GetAll[OrganizationSearchMembersRequest, OrganizationSearchMembersResponseUser](client, "/organizations/search_members", request, "users")
+}
+
+// readOrganizationMember returns the member of the organization with the given login, if it exists
+func readOrganizationMember(client *sonarcloud.Client, login string) (OrganizationMember, bool, error) {
+	users, err := searchOrganizationMembers(client, login)
+	if err != nil {
+		return OrganizationMember{}, false, err
+	}
+
+	for _, u := range users {
+		if u.Login == login {
+			return OrganizationMember{
+				ID:    types.String{Value: u.Login},
+				Login: types.String{Value: u.Login},
+				Name:  types.String{Value: u.Name},
+			}, true, nil
+		}
+	}
+	return OrganizationMember{}, false, nil
+}
@@ -0,0 +1,52 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccOrganizationMember(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TEST_NEW_MEMBER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if login == "" {
+				t.Skip("SONARCLOUD_TEST_NEW_MEMBER_LOGIN must be set to test organization members")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberConfig(login),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_organization_member.test", "id", login),
+					resource.TestCheckResourceAttr("sonarcloud_organization_member.test", "login", login),
+					resource.TestCheckResourceAttrSet("sonarcloud_organization_member.test", "name"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_organization_member.test",
+				ImportState:       true,
+				ImportStateId:     login,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccOrganizationMemberDestroy,
+	})
+}
+
+func testAccOrganizationMemberDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccOrganizationMemberConfig(login string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_organization_member" "test" {
+	login = "%s"
+}
+`, login)
+}
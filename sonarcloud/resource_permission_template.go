@@ -0,0 +1,238 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourcePermissionTemplateType struct{}
+
+func (r resourcePermissionTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a permission template. The permissions of a template are applied to new " +
+			"projects of which the key matches the project key pattern, or to all new projects if it is the default template.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The ID of the permission template.",
+			},
+			"name": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name of the permission template.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 100),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The description of the permission template.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 4000),
+				},
+			},
+			"project_key_pattern": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The project key pattern. Must be a valid Java regular expression, e.g. `my_org_.*`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 500),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionTemplateType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissionTemplate{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissionTemplate struct {
+	p provider
+}
+
+func (r resourcePermissionTemplate) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := permissions.CreateTemplateRequest{
+		Description:       plan.Description.Value,
+		Name:              plan.Name.Value,
+		Organization:      r.p.organization,
+		ProjectKeyPattern: plan.ProjectKeyPattern.Value,
+	}
+	if _, err := r.p.client.Permissions.CreateTemplate(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the permission template",
+			fmt.Sprintf("The CreateTemplate request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// The response does not contain the ID of the template, so we have to look it up by name
+	response, err := searchPermissionTemplates(r.p.client)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permission template",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	template, ok := findPermissionTemplateByName(response, plan.Name.Value)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the permission template",
+			fmt.Sprintf("The permission template '%s' was not found after creating it.", plan.Name.Value),
+		)
+		return
+	}
+
+	result := plan
+	result.ID = types.String{Value: template.Id}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplate) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state PermissionTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := searchPermissionTemplates(r.p.client)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permission template",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	template, ok := findPermissionTemplate(response, state.ID.Value)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := PermissionTemplate{
+		ID:                types.String{Value: template.Id},
+		Name:              types.String{Value: template.Name},
+		Description:       optionalString(template.Description),
+		ProjectKeyPattern: optionalString(template.ProjectKeyPattern),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplate) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from state
+	var state PermissionTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplate
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := PermissionTemplateUpdateRequest{
+		Description:       plan.Description.Value,
+		Id:                state.ID.Value,
+		Name:              plan.Name.Value,
+		ProjectKeyPattern: plan.ProjectKeyPattern.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/permissions/update_template", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the permission template",
+			fmt.Sprintf("The UpdateTemplate request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result := plan
+	result.ID = state.ID
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplate) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PermissionTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := permissions.DeleteTemplateRequest{
+		Organization: r.p.organization,
+		TemplateId:   state.ID.Value,
+	}
+	if err := r.p.client.Permissions.DeleteTemplate(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not delete the permission template",
+			fmt.Sprintf("The DeleteTemplate request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePermissionTemplate) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// optionalString returns a null string for empty values, as SonarCloud omits empty optional fields
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.String{Null: true}
+	}
+	return types.String{Value: value}
+}
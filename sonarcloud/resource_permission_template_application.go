@@ -0,0 +1,164 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourcePermissionTemplateApplicationType struct{}
+
+func (r resourcePermissionTemplateApplicationType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource applies a permission template to existing projects, replacing their current permissions.
+
+The template is applied when the resource is created, and again whenever one of its attributes changes. Use the
+` + "`triggers`" + ` to re-apply the template after changing it. Destroying this resource does not revert the permissions
+of the projects.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the ID of the permission template.",
+			},
+			"template_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the permission template to apply.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"project_keys": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The keys of the projects to apply the template to. Conflicts with `query`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"query": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "Apply the template to all projects of which the name contains, or the key equals, this string. " +
+					"Conflicts with `project_keys`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				Type:        types.MapType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Arbitrary values that re-apply the template when changed.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionTemplateApplicationType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissionTemplateApplication{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissionTemplateApplication struct {
+	p provider
+}
+
+func (r resourcePermissionTemplateApplication) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		exactlyOneOf(path.Root("project_keys"), path.Root("query")),
+	}
+}
+
+func (r resourcePermissionTemplateApplication) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplateApplication
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKeys.Null {
+		request := permissions.BulkApplyTemplateRequest{
+			Organization: r.p.organization,
+			Q:            plan.Query.Value,
+			TemplateId:   plan.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.BulkApplyTemplate(request); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not apply the permission template",
+				fmt.Sprintf("The BulkApplyTemplate request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	} else {
+		// Apply the template to every project, so that a single failing project does not block the others
+		for _, elem := range plan.ProjectKeys.Elems {
+			projectKey := elem.(types.String).Value
+			request := permissions.ApplyTemplateRequest{
+				Organization: r.p.organization,
+				ProjectKey:   projectKey,
+				TemplateId:   plan.TemplateID.Value,
+			}
+			if err := r.p.client.Permissions.ApplyTemplate(request); err != nil {
+				resp.Diagnostics.Append(apiAttributeErrorDiagnostic(
+					path.Root("project_keys"),
+					fmt.Sprintf("Could not apply the permission template to project '%s'", projectKey),
+					fmt.Sprintf("The ApplyTemplate request returned an error: %+v", err),
+					err,
+				))
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result := plan
+	result.ID = plan.TemplateID
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateApplication) Read(_ context.Context, _ tfsdk.ReadResourceRequest, _ *tfsdk.ReadResourceResponse) {
+	// Applying a template is a one-off action, so there is nothing to read back
+}
+
+func (r resourcePermissionTemplateApplication) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// All attributes require replacement, so an update can only be a no-op
+	var plan PermissionTemplateApplication
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateApplication) Delete(ctx context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The permissions of the projects are left as is
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,70 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccPermissionTemplateApplication(t *testing.T) {
+	template := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	missingKey := "tf-acc-test-missing-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionTemplateApplicationConfig(template, []string{projectKey}, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_permission_template_application.test", "id", "sonarcloud_permission_template.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_application.test", "project_keys.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_application.test", "triggers.revision", "1"),
+				),
+			},
+			{
+				Config: testAccPermissionTemplateApplicationConfig(template, []string{projectKey}, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_application.test", "triggers.revision", "2"),
+				),
+			},
+			{
+				Config:      testAccPermissionTemplateApplicationConfig(template, []string{projectKey, missingKey}, "2"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Could not apply the permission template to project '%s'", missingKey)),
+			},
+		},
+		CheckDestroy: testAccPermissionTemplateApplicationDestroy,
+	})
+}
+
+func testAccPermissionTemplateApplicationDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionTemplateApplicationConfig(template string, projectKeys []string, revision string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_permission_template" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_permission_template_group_permissions" "test" {
+	template_id = sonarcloud_permission_template.test.id
+	name        = "Members"
+	permissions = ["user", "codeviewer"]
+}
+
+resource "sonarcloud_permission_template_application" "test" {
+	template_id  = sonarcloud_permission_template_group_permissions.test.template_id
+	project_keys = %s
+
+	triggers = {
+		revision = "%s"
+	}
+}
+`, template, terraformListString(projectKeys), revision)
+}
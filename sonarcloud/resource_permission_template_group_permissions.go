@@ -0,0 +1,234 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourcePermissionTemplateGroupPermissionsType struct{}
+
+func (r resourcePermissionTemplateGroupPermissionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the permissions that a permission template grants to a user group.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, in the format `template_id,name`.",
+			},
+			"template_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the permission template.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name of the user group, or `anyone` for all users.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"permissions": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Required: true,
+				Description: "List of permissions to grant." +
+					" Available permissions: [`admin`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `scan`, `user`].",
+				Validators: []tfsdk.AttributeValidator{
+					allowedSetOptions(projectPermissions...),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionTemplateGroupPermissionsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissionTemplateGroupPermissions{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissionTemplateGroupPermissions struct {
+	p provider
+}
+
+func (r resourcePermissionTemplateGroupPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplateGroupPermissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.addPermissions(plan, plan.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = types.String{Value: fmt.Sprintf("%s,%s", plan.TemplateID.Value, plan.Name.Value)}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateGroupPermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state PermissionTemplateGroupPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, ok, err := readPermissionTemplateGroup(r.p.client, state.TemplateID.Value, state.Name.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permission template group permissions",
+			fmt.Sprintf("The TemplateGroups request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The name is kept as configured, because the API returns the group of all users as "Anyone"
+	result := PermissionTemplateGroupPermissions{
+		ID:          types.String{Value: fmt.Sprintf("%s,%s", state.TemplateID.Value, state.Name.Value)},
+		TemplateID:  state.TemplateID,
+		Name:        state.Name,
+		Permissions: stringSetOf(group.Permissions),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateGroupPermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var state PermissionTemplateGroupPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan PermissionTemplateGroupPermissions
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.Permissions, plan.Permissions)
+
+	if !r.removePermissions(state, toRemove, &resp.Diagnostics) {
+		return
+	}
+	if !r.addPermissions(plan, toAdd, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = state.ID
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateGroupPermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PermissionTemplateGroupPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.removePermissions(state, state.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePermissionTemplateGroupPermissions) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: template_id,name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+// addPermissions adds the given permissions of the group to the template, and returns whether all of them were added
+func (r resourcePermissionTemplateGroupPermissions) addPermissions(plan PermissionTemplateGroupPermissions, toAdd []attr.Value, diags *diag.Diagnostics) bool {
+	for _, add := range toAdd {
+		request := permissions.AddGroupToTemplateRequest{
+			GroupName:    plan.Name.Value,
+			Organization: r.p.organization,
+			Permission:   add.(types.String).Value,
+			TemplateId:   plan.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.AddGroupToTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the permission to the permission template",
+				fmt.Sprintf("The AddGroupToTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
+
+// removePermissions removes the given permissions of the group from the template, and returns whether all of them were removed
+func (r resourcePermissionTemplateGroupPermissions) removePermissions(state PermissionTemplateGroupPermissions, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	for _, remove := range toRemove {
+		request := permissions.RemoveGroupFromTemplateRequest{
+			GroupName:    state.Name.Value,
+			Organization: r.p.organization,
+			Permission:   remove.(types.String).Value,
+			TemplateId:   state.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.RemoveGroupFromTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the permission from the permission template",
+				fmt.Sprintf("The RemoveGroupFromTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
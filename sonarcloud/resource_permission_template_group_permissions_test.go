@@ -0,0 +1,61 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccPermissionTemplateGroupPermissions(t *testing.T) {
+	template := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := os.Getenv("SONARCLOUD_TEST_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionTemplateGroupPermissionsConfig(template, name, []string{"user", "codeviewer"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_permission_template_group_permissions.test", "template_id", "sonarcloud_permission_template.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_group_permissions.test", "name", name),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_group_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_group_permissions.test", "permissions.*", "user"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_group_permissions.test", "permissions.*", "codeviewer"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_group_permissions.test"),
+			{
+				Config: testAccPermissionTemplateGroupPermissionsConfig(template, name, []string{"user", "issueadmin"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_group_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_group_permissions.test", "permissions.*", "user"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_group_permissions.test", "permissions.*", "issueadmin"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_group_permissions.test"),
+		},
+		CheckDestroy: testAccPermissionTemplateGroupPermissionsDestroy,
+	})
+}
+
+func testAccPermissionTemplateGroupPermissionsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionTemplateGroupPermissionsConfig(template, name string, permissions []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_permission_template" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_permission_template_group_permissions" "test" {
+	template_id = sonarcloud_permission_template.test.id
+	name        = "%s"
+	permissions = %s
+}
+`, template, name, terraformListString(permissions))
+}
@@ -0,0 +1,224 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourcePermissionTemplateProjectCreatorPermissionsType struct{}
+
+func (r resourcePermissionTemplateProjectCreatorPermissionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the permissions that a permission template grants to the creator of a project.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the ID of the permission template.",
+			},
+			"template_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the permission template.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"permissions": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Required: true,
+				Description: "List of permissions to grant." +
+					" Available permissions: [`admin`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `scan`, `user`].",
+				Validators: []tfsdk.AttributeValidator{
+					allowedSetOptions(projectPermissions...),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissionsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissionTemplateProjectCreatorPermissions{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissionTemplateProjectCreatorPermissions struct {
+	p provider
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplateProjectCreatorPermissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.addPermissions(plan, plan.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = types.String{Value: plan.TemplateID.Value}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state PermissionTemplateProjectCreatorPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := searchPermissionTemplates(r.p.client)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permission template project creator permissions",
+			fmt.Sprintf("The SearchTemplates request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	template, ok := findPermissionTemplate(response, state.TemplateID.Value)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	projectCreatorPermissions := make([]string, 0)
+	for _, p := range template.Permissions {
+		if p.WithProjectCreator {
+			projectCreatorPermissions = append(projectCreatorPermissions, p.Key)
+		}
+	}
+	if len(projectCreatorPermissions) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := PermissionTemplateProjectCreatorPermissions{
+		ID:          types.String{Value: template.Id},
+		TemplateID:  types.String{Value: template.Id},
+		Permissions: stringSetOf(projectCreatorPermissions),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var state PermissionTemplateProjectCreatorPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan PermissionTemplateProjectCreatorPermissions
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.Permissions, plan.Permissions)
+
+	if !r.removePermissions(state, toRemove, &resp.Diagnostics) {
+		return
+	}
+	if !r.addPermissions(plan, toAdd, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = state.ID
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PermissionTemplateProjectCreatorPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.removePermissions(state, state.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePermissionTemplateProjectCreatorPermissions) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("template_id"), req, resp)
+}
+
+// addPermissions adds the given project creator permissions to the template, and returns whether all of them were added
+func (r resourcePermissionTemplateProjectCreatorPermissions) addPermissions(plan PermissionTemplateProjectCreatorPermissions, toAdd []attr.Value, diags *diag.Diagnostics) bool {
+	for _, add := range toAdd {
+		request := permissions.AddProjectCreatorToTemplateRequest{
+			Organization: r.p.organization,
+			Permission:   add.(types.String).Value,
+			TemplateId:   plan.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.AddProjectCreatorToTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the permission to the permission template",
+				fmt.Sprintf("The AddProjectCreatorToTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
+
+// removePermissions removes the given project creator permissions from the template, and returns whether all of them were removed
+func (r resourcePermissionTemplateProjectCreatorPermissions) removePermissions(state PermissionTemplateProjectCreatorPermissions, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	for _, remove := range toRemove {
+		request := permissions.RemoveProjectCreatorFromTemplateRequest{
+			Organization: r.p.organization,
+			Permission:   remove.(types.String).Value,
+			TemplateId:   state.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.RemoveProjectCreatorFromTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the permission from the permission template",
+				fmt.Sprintf("The RemoveProjectCreatorFromTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccPermissionTemplateProjectCreatorPermissions(t *testing.T) {
+	template := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionTemplateProjectCreatorPermissionsConfig(template, []string{"admin", "scan"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_permission_template_project_creator_permissions.test", "template_id", "sonarcloud_permission_template.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.*", "admin"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.*", "scan"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_project_creator_permissions.test"),
+			{
+				Config: testAccPermissionTemplateProjectCreatorPermissionsConfig(template, []string{"admin", "issueadmin"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.*", "admin"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_project_creator_permissions.test", "permissions.*", "issueadmin"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_project_creator_permissions.test"),
+		},
+		CheckDestroy: testAccPermissionTemplateProjectCreatorPermissionsDestroy,
+	})
+}
+
+func testAccPermissionTemplateProjectCreatorPermissionsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionTemplateProjectCreatorPermissionsConfig(template string, permissions []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_permission_template" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_permission_template_project_creator_permissions" "test" {
+	template_id = sonarcloud_permission_template.test.id
+	permissions = %s
+}
+`, template, terraformListString(permissions))
+}
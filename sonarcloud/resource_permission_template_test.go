@@ -0,0 +1,65 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccPermissionTemplate(t *testing.T) {
+	names := []string{
+		"tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum),
+		"tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionTemplateConfig(names[0], "A template for testing", "tf_acc_test_.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarcloud_permission_template.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "name", names[0]),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "description", "A template for testing"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "project_key_pattern", "tf_acc_test_.*"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template.test"),
+			{
+				Config: testAccPermissionTemplateConfig(names[1], "An updated template for testing", "tf_acc_test_updated_.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "name", names[1]),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "description", "An updated template for testing"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template.test", "project_key_pattern", "tf_acc_test_updated_.*"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template.test"),
+		},
+		CheckDestroy: testAccPermissionTemplateDestroy,
+	})
+}
+
+func testAccPermissionTemplateDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionTemplateConfig(name, description, pattern string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_permission_template" "test" {
+	name                = "%s"
+	description         = "%s"
+	project_key_pattern = "%s"
+}
+`, name, description, pattern)
+}
+
+func permissionTemplateImportCheck(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
@@ -0,0 +1,233 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+)
+
+type resourcePermissionTemplateUserPermissionsType struct{}
+
+func (r resourcePermissionTemplateUserPermissionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the permissions that a permission template grants to a user.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, in the format `template_id,login`.",
+			},
+			"template_id": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the permission template.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"login": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The login of the user.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"permissions": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Required: true,
+				Description: "List of permissions to grant." +
+					" Available permissions: [`admin`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `scan`, `user`].",
+				Validators: []tfsdk.AttributeValidator{
+					allowedSetOptions(projectPermissions...),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionTemplateUserPermissionsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissionTemplateUserPermissions{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissionTemplateUserPermissions struct {
+	p provider
+}
+
+func (r resourcePermissionTemplateUserPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PermissionTemplateUserPermissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.addPermissions(plan, plan.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = types.String{Value: fmt.Sprintf("%s,%s", plan.TemplateID.Value, plan.Login.Value)}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateUserPermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state PermissionTemplateUserPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, ok, err := readPermissionTemplateUser(r.p.client, state.TemplateID.Value, state.Login.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permission template user permissions",
+			fmt.Sprintf("The TemplateUsers request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := PermissionTemplateUserPermissions{
+		ID:          types.String{Value: fmt.Sprintf("%s,%s", state.TemplateID.Value, user.Login)},
+		TemplateID:  state.TemplateID,
+		Login:       types.String{Value: user.Login},
+		Permissions: stringSetOf(user.Permissions),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateUserPermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var state PermissionTemplateUserPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan PermissionTemplateUserPermissions
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.Permissions, plan.Permissions)
+
+	if !r.removePermissions(state, toRemove, &resp.Diagnostics) {
+		return
+	}
+	if !r.addPermissions(plan, toAdd, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = state.ID
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissionTemplateUserPermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state PermissionTemplateUserPermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.removePermissions(state, state.Permissions.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePermissionTemplateUserPermissions) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: template_id,login. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("login"), idParts[1])...)
+}
+
+// addPermissions adds the given permissions of the user to the template, and returns whether all of them were added
+func (r resourcePermissionTemplateUserPermissions) addPermissions(plan PermissionTemplateUserPermissions, toAdd []attr.Value, diags *diag.Diagnostics) bool {
+	for _, add := range toAdd {
+		request := permissions.AddUserToTemplateRequest{
+			Login:        plan.Login.Value,
+			Organization: r.p.organization,
+			Permission:   add.(types.String).Value,
+			TemplateId:   plan.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.AddUserToTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the permission to the permission template",
+				fmt.Sprintf("The AddUserToTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
+
+// removePermissions removes the given permissions of the user from the template, and returns whether all of them were removed
+func (r resourcePermissionTemplateUserPermissions) removePermissions(state PermissionTemplateUserPermissions, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	for _, remove := range toRemove {
+		request := permissions.RemoveUserFromTemplateRequest{
+			Login:        state.Login.Value,
+			Organization: r.p.organization,
+			Permission:   remove.(types.String).Value,
+			TemplateId:   state.TemplateID.Value,
+		}
+		if err := r.p.client.Permissions.RemoveUserFromTemplate(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the permission from the permission template",
+				fmt.Sprintf("The RemoveUserFromTemplate request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccPermissionTemplateUserPermissions(t *testing.T) {
+	template := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionTemplateUserPermissionsConfig(template, login, []string{"user", "codeviewer"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_permission_template_user_permissions.test", "template_id", "sonarcloud_permission_template.test", "id"),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_user_permissions.test", "login", login),
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_user_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_user_permissions.test", "permissions.*", "user"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_user_permissions.test", "permissions.*", "codeviewer"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_user_permissions.test"),
+			{
+				Config: testAccPermissionTemplateUserPermissionsConfig(template, login, []string{"user", "issueadmin"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permission_template_user_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_user_permissions.test", "permissions.*", "user"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_permission_template_user_permissions.test", "permissions.*", "issueadmin"),
+				),
+			},
+			permissionTemplateImportCheck("sonarcloud_permission_template_user_permissions.test"),
+		},
+		CheckDestroy: testAccPermissionTemplateUserPermissionsDestroy,
+	})
+}
+
+func testAccPermissionTemplateUserPermissionsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionTemplateUserPermissionsConfig(template, login string, permissions []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_permission_template" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_permission_template_user_permissions" "test" {
+	template_id = sonarcloud_permission_template.test.id
+	login       = "%s"
+	permissions = %s
+}
+`, template, login, terraformListString(permissions))
+}
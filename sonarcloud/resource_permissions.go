@@ -0,0 +1,242 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourcePermissionsType struct{}
+
+func (r resourcePermissionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource manages all permissions of the whole organization or a specific project.
+
+This resource is authoritative: every permission that is granted to a group or user, but is not declared in this
+resource, is revoked on the next apply. Do not use it together with ` + "`sonarcloud_user_permissions`" + ` or
+` + "`sonarcloud_user_group_permissions`" + ` resources for the same organization or project. Make sure to keep the ` + "`admin`" + `
+permission of the user that Terraform runs as, or you will lock it out.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the project key, or the key of the organization when no project key is set.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the project to manage the permissions of. Manages the permissions of the organization when not set.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"permissions": {
+				Required: true,
+				Description: "The groups and users that are granted each permission, keyed by permission." +
+					" Available global permissions: [`admin`, `profileadmin`, `gateadmin`, `scan`, `provisioning`]." +
+					" Available project permissions: [`admin`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `scan`, `user`].",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"groups": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The names of the groups that are granted the permission.",
+					},
+					"users": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The logins of the users that are granted the permission.",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r resourcePermissionsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourcePermissions{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourcePermissions struct {
+	p provider
+}
+
+func (r resourcePermissions) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		permissionsInScope(path.Root("project_key"), path.Root("permissions")),
+	}
+}
+
+func (r resourcePermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan Permissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.apply(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = r.id(plan.ProjectKey)
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state Permissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All grants are read, so grants that have been made outside of Terraform show up as drift
+	grants, err := readPermissionGrants(r.p.client, state.ProjectKey.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the permissions",
+			fmt.Sprintf("The request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result := Permissions{
+		ID:          r.id(state.ProjectKey),
+		ProjectKey:  state.ProjectKey,
+		Permissions: permissionMatrixFrom(grants, state.Permissions),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan Permissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.apply(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = r.id(plan.ProjectKey)
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourcePermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state Permissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the declared grants are revoked, everything else is left as is
+	_, toRemove := diffPermissionGrants(nil, permissionGrantsFrom(state.Permissions))
+	if !r.removeGrants(ctx, state.ProjectKey.Value, toRemove, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourcePermissions) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	if req.ID != r.p.organization {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), req.ID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply grants all planned permissions and then revokes all permissions that are not planned, so that a grant that
+// moves from one group to another is never missing in between
+func (r resourcePermissions) apply(ctx context.Context, plan Permissions, diags *diag.Diagnostics) bool {
+	grants, err := readPermissionGrants(r.p.client, plan.ProjectKey.Value)
+	if err != nil {
+		diags.Append(apiErrorDiagnostic(
+			"Could not read the permissions",
+			fmt.Sprintf("The request returned an error: %+v", err),
+			err,
+		))
+		return false
+	}
+
+	toAdd, toRemove := diffPermissionGrants(grants, permissionGrantsFrom(plan.Permissions))
+	if !r.addGrants(ctx, plan.ProjectKey.Value, toAdd, diags) {
+		return false
+	}
+	return r.removeGrants(ctx, plan.ProjectKey.Value, toRemove, diags)
+}
+
+// addGrants grants the given permissions, and returns whether all of them were granted
+func (r resourcePermissions) addGrants(ctx context.Context, projectKey string, toAdd []permissionGrant, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toAdd, func(grant permissionGrant) error {
+		return addPermissionGrant(r.p.client, r.p.organization, projectKey, grant)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not grant the permission",
+				fmt.Sprintf("Granting the permission %s returned an error: %+v", toAdd[i], err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
+
+// removeGrants revokes the given permissions, and returns whether all of them were revoked
+func (r resourcePermissions) removeGrants(ctx context.Context, projectKey string, toRemove []permissionGrant, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toRemove, func(grant permissionGrant) error {
+		return removePermissionGrant(r.p.client, r.p.organization, projectKey, grant)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not revoke the permission",
+				fmt.Sprintf("Revoking the permission %s returned an error: %+v", toRemove[i], err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
+
+// id returns the ID of the resource, which is the project key or the key of the organization
+func (r resourcePermissions) id(projectKey types.String) types.String {
+	if projectKey.Null || projectKey.Value == "" {
+		return types.String{Value: r.p.organization}
+	}
+	return projectKey
+}
@@ -0,0 +1,77 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccPermissions(t *testing.T) {
+	projectKey := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig(projectKey, fmt.Sprintf(`
+		admin = {
+			groups = ["Owners"]
+			users  = ["%s"]
+		}
+		scan = {
+			groups = ["Owners", "Members"]
+		}
+`, login)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "permissions.%", "2"),
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "permissions.admin.users.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "permissions.scan.groups.#", "2"),
+				),
+			},
+			{
+				Config: testAccPermissionsConfig(projectKey, `
+		admin = {
+			groups = ["Owners"]
+		}
+		issueadmin = {
+			groups = ["Members"]
+			users  = []
+		}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "permissions.%", "2"),
+					resource.TestCheckNoResourceAttr("sonarcloud_permissions.test", "permissions.admin.users"),
+					resource.TestCheckResourceAttr("sonarcloud_permissions.test", "permissions.issueadmin.users.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccPermissionsDestroy,
+	})
+}
+
+func testAccPermissionsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccPermissionsConfig(projectKey, permissions string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project" "test" {
+	key        = "%s"
+	name       = "%s"
+	visibility = "private"
+}
+
+resource "sonarcloud_permissions" "test" {
+	project_key = sonarcloud_project.test.key
+	permissions = {
+%s
+	}
+}
+`, projectKey, projectKey, permissions)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
 )
 
@@ -51,6 +52,16 @@ func (r resourceProjectType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					allowedOptions("public", "private"),
 				},
 			},
+			"automatic_analysis": {
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+				Description: "Whether automatic analysis is enabled for the project. Automatic analysis must be disabled " +
+					"to analyze the project in a CI pipeline. Defaults to the value SonarCloud picks for new projects.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 		},
 	}, nil
 }
@@ -93,18 +104,43 @@ func (r resourceProject) Create(ctx context.Context, req tfsdk.CreateResourceReq
 
 	res, err := r.p.client.Projects.Create(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the project",
 			fmt.Sprintf("The Create request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
+	if !plan.AutomaticAnalysis.Unknown && !plan.AutomaticAnalysis.Null {
+		if err := setAutomaticAnalysis(r.p.client, res.Project.Key, plan.AutomaticAnalysis.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not set automatic analysis for the project",
+				fmt.Sprintf("The Activation request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	current := plan.AutomaticAnalysis
+	if current.Unknown {
+		current = types.Bool{Null: true}
+	}
+	automaticAnalysis, err := readAutomaticAnalysis(r.p.client, res.Project.Key, current)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read automatic analysis for the project",
+			fmt.Sprintf("The Eligibility request returned an error, the planned value is kept: %+v", err),
+		)
+	}
+
 	var result = Project{
-		ID:         types.String{Value: res.Project.Key},
-		Name:       types.String{Value: res.Project.Name},
-		Key:        types.String{Value: res.Project.Key},
-		Visibility: types.String{Value: plan.Visibility.Value},
+		ID:                types.String{Value: res.Project.Key},
+		Name:              types.String{Value: res.Project.Name},
+		Key:               types.String{Value: res.Project.Key},
+		Visibility:        types.String{Value: plan.Visibility.Value},
+		AutomaticAnalysis: automaticAnalysis,
 	}
 	diags = resp.State.Set(ctx, result)
 
@@ -127,20 +163,36 @@ func (r resourceProject) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 
 	response, err := r.p.client.Projects.SearchAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
 	// Check if the resource exists the list of retrieved resources
-	if result, ok := findProject(response, state.Key.Value); ok {
-		diags = resp.State.Set(ctx, result)
-		resp.Diagnostics.Append(diags...)
-	} else {
+	result, ok := findProject(response, state.Key.Value)
+	if !ok {
 		resp.State.RemoveResource(ctx)
+		return
 	}
+
+	automaticAnalysis, err := readAutomaticAnalysis(r.p.client, result.Key.Value, state.AutomaticAnalysis)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read automatic analysis for the project",
+			fmt.Sprintf("The Eligibility request returned an error, the previous value is kept: %+v", err),
+		)
+	}
+	result.AutomaticAnalysis = automaticAnalysis
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
@@ -160,7 +212,8 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed, diags := changedAttrs(req)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -173,10 +226,11 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 		err := r.p.client.Projects.UpdateKey(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Could not update the project key",
 				fmt.Sprintf("The UpdateKey request returned an error: %+v", err),
-			)
+				err,
+			))
 			return
 		}
 	}
@@ -189,10 +243,23 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 		err := r.p.client.Projects.UpdateVisibility(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Could not update the project visibility",
 				fmt.Sprintf("The UpdateVisibility request returned an error: %+v", err),
-			)
+				err,
+			))
+			return
+		}
+	}
+
+	if _, ok := changed["automatic_analysis"]; ok && !plan.AutomaticAnalysis.Unknown && !plan.AutomaticAnalysis.Null {
+		err := setAutomaticAnalysis(r.p.client, plan.Key.Value, plan.AutomaticAnalysis.Value)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not update automatic analysis for the project",
+				fmt.Sprintf("The Activation request returned an error: %+v", err),
+				err,
+			))
 			return
 		}
 	}
@@ -203,15 +270,29 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 	response, err := r.p.client.Projects.SearchAll(searchRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
 	// Check if the resource exists the list of retrieved resources
 	if result, ok := findProject(response, plan.Key.Value); ok {
+		current := plan.AutomaticAnalysis
+		if current.Unknown {
+			current = state.AutomaticAnalysis
+		}
+		automaticAnalysis, err := readAutomaticAnalysis(r.p.client, result.Key.Value, current)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not read automatic analysis for the project",
+				fmt.Sprintf("The Eligibility request returned an error, the planned value is kept: %+v", err),
+			)
+		}
+		result.AutomaticAnalysis = automaticAnalysis
+
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	}
@@ -232,10 +313,11 @@ func (r resourceProject) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 
 	err := r.p.client.Projects.Delete(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the project",
 			fmt.Sprintf("The Delete request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -245,3 +327,36 @@ func (r resourceProject) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 func (r resourceProject) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("key"), req, resp)
 }
+
+type AutoscanActivationRequest struct {
+	Enable     bool   `form:"enable"`
+	ProjectKey string `form:"projectKey,omitempty"`
+}
+
+type AutoscanEligibilityResponse struct {
+	AutoscanEnabled bool `json:"autoscanEnabled"`
+}
+
+// setAutomaticAnalysis enables or disables automatic analysis for the project with the given key
+func setAutomaticAnalysis(client *sonarcloud.Client, projectKey string, enable bool) error {
+	return sonarcloud.Post(client, "/autoscan/activation", AutoscanActivationRequest{
+		Enable:     enable,
+		ProjectKey: projectKey,
+	})
+}
+
+// readAutomaticAnalysis returns whether automatic analysis is enabled for the project with the given key. The value
+// cannot be read for every project, in which case current is returned as is. That is not an error when the API
+// responds with a 404, because that only means the project is not eligible for automatic analysis.
+func readAutomaticAnalysis(client *sonarcloud.Client, projectKey string, current types.Bool) (types.Bool, error) {
+	response, err := getWithResponse[AutoscanEligibilityResponse](client, "/autoscan/eligibility",
+		"projectKey", projectKey,
+	)
+	if err != nil {
+		if isNotFound(err) {
+			return current, nil
+		}
+		return current, err
+	}
+	return types.Bool{Value: response.AutoscanEnabled}, nil
+}
@@ -0,0 +1,69 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccProjectAzureBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	azureProjectName := os.Getenv("SONARCLOUD_AZURE_PROJECT_NAME")
+	repositoryName := os.Getenv("SONARCLOUD_AZURE_REPOSITORY_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if azureProjectName == "" || repositoryName == "" {
+				t.Skip("SONARCLOUD_AZURE_PROJECT_NAME and SONARCLOUD_AZURE_REPOSITORY_NAME must be set to test Azure DevOps bindings")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAzureBindingConfig(projectKey, azureProjectName, repositoryName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "azure_project_name", azureProjectName),
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "repository_name", repositoryName),
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "monorepo", "false"),
+				),
+			},
+			projectAzureBindingImportCheck("sonarcloud_project_azure_binding.test", projectKey),
+			{
+				Config: testAccProjectAzureBindingConfig(projectKey, azureProjectName, repositoryName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "monorepo", "true"),
+				),
+			},
+			projectAzureBindingImportCheck("sonarcloud_project_azure_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectAzureBindingDestroy,
+	})
+}
+
+func testAccProjectAzureBindingDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectAzureBindingConfig(projectKey, azureProjectName, repositoryName string, monorepo bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_azure_binding" "test" {
+	project_key        = "%s"
+	azure_project_name = "%s"
+	repository_name    = "%s"
+	monorepo           = %t
+}
+`, projectKey, azureProjectName, repositoryName, monorepo)
+}
+
+func projectAzureBindingImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
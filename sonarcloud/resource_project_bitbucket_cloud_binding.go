@@ -0,0 +1,241 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectBitbucketCloudBindingType struct{}
+
+func (r resourceProjectBitbucketCloudBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource binds a project to a Bitbucket Cloud repository. The binding enables pull request " +
+			"decoration. The organization must be bound to the Bitbucket Cloud workspace of the repository.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the project.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 400),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"repository": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The slug of the Bitbucket Cloud repository.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 256),
+				},
+			},
+			"monorepo": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the repository is a monorepo that contains multiple projects. Defaults to `false`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectBitbucketCloudBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBitbucketCloudBinding{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectBitbucketCloudBinding struct {
+	p provider
+}
+
+func (r resourceProjectBitbucketCloudBinding) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectBitbucketCloudBinding
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := sonarcloud.Post(r.p.client, "/alm_settings/set_bitbucketcloud_binding", r.setRequest(plan)); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the Bitbucket Cloud binding",
+			fmt.Sprintf("The SetBitbucketCloudBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readProjectBitbucketCloudBinding(r.p.client, plan.ProjectKey.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Bitbucket Cloud binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Bitbucket Cloud binding",
+			fmt.Sprintf("The project '%s' is not bound to a Bitbucket Cloud repository after creating the binding.", plan.ProjectKey.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBitbucketCloudBinding) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state ProjectBitbucketCloudBinding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readProjectBitbucketCloudBinding(r.p.client, state.ProjectKey.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Bitbucket Cloud binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceProjectBitbucketCloudBinding) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan ProjectBitbucketCloudBinding
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the binding again overwrites the existing binding
+	if err := sonarcloud.Post(r.p.client, "/alm_settings/set_bitbucketcloud_binding", r.setRequest(plan)); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the Bitbucket Cloud binding",
+			fmt.Sprintf("The SetBitbucketCloudBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readProjectBitbucketCloudBinding(r.p.client, plan.ProjectKey.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Bitbucket Cloud binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Bitbucket Cloud binding",
+			fmt.Sprintf("The project '%s' is not bound to a Bitbucket Cloud repository after updating the binding.", plan.ProjectKey.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBitbucketCloudBinding) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ProjectBitbucketCloudBinding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteAlmBinding(r.p.client, r.p.organization, state.ProjectKey.Value); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not delete the Bitbucket Cloud binding",
+			fmt.Sprintf("The DeleteBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectBitbucketCloudBinding) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// setRequest returns the request to bind the project to the planned repository, using the default for an unknown monorepo flag
+func (r resourceProjectBitbucketCloudBinding) setRequest(plan ProjectBitbucketCloudBinding) BitbucketCloudBindingSetRequest {
+	return BitbucketCloudBindingSetRequest{
+		Monorepo:     !plan.Monorepo.Unknown && plan.Monorepo.Value,
+		Organization: r.p.organization,
+		Project:      plan.ProjectKey.Value,
+		Repository:   plan.Repository.Value,
+	}
+}
+
+type BitbucketCloudBindingSetRequest struct {
+	Monorepo     bool   `form:"monorepo"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+	Repository   string `form:"repository,omitempty"`
+}
+
+// readProjectBitbucketCloudBinding returns the Bitbucket Cloud binding of the project with the given key, if it is bound to Bitbucket Cloud
+func readProjectBitbucketCloudBinding(client *sonarcloud.Client, projectKey string) (ProjectBitbucketCloudBinding, bool, error) {
+	response, ok, err := readAlmBinding(client, projectKey, "bitbucketcloud")
+	if err != nil || !ok {
+		return ProjectBitbucketCloudBinding{}, false, err
+	}
+
+	return ProjectBitbucketCloudBinding{
+		ID:         types.String{Value: projectKey},
+		ProjectKey: types.String{Value: projectKey},
+		Repository: types.String{Value: response.Repository},
+		Monorepo:   types.Bool{Value: response.Monorepo},
+	}, true, nil
+}
@@ -0,0 +1,66 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccProjectBitbucketCloudBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	repository := os.Getenv("SONARCLOUD_BITBUCKET_CLOUD_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if repository == "" {
+				t.Skip("SONARCLOUD_BITBUCKET_CLOUD_REPOSITORY must be set to test Bitbucket Cloud bindings")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectBitbucketCloudBindingConfig(projectKey, repository, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_bitbucket_cloud_binding.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_bitbucket_cloud_binding.test", "repository", repository),
+					resource.TestCheckResourceAttr("sonarcloud_project_bitbucket_cloud_binding.test", "monorepo", "false"),
+				),
+			},
+			projectBitbucketCloudBindingImportCheck("sonarcloud_project_bitbucket_cloud_binding.test", projectKey),
+			{
+				Config: testAccProjectBitbucketCloudBindingConfig(projectKey, repository, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_bitbucket_cloud_binding.test", "monorepo", "true"),
+				),
+			},
+			projectBitbucketCloudBindingImportCheck("sonarcloud_project_bitbucket_cloud_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectBitbucketCloudBindingDestroy,
+	})
+}
+
+func testAccProjectBitbucketCloudBindingDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectBitbucketCloudBindingConfig(projectKey, repository string, monorepo bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_bitbucket_cloud_binding" "test" {
+	project_key = "%s"
+	repository  = "%s"
+	monorepo    = %t
+}
+`, projectKey, repository, monorepo)
+}
+
+func projectBitbucketCloudBindingImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
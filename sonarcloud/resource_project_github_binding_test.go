@@ -0,0 +1,67 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccProjectGithubBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	repository := os.Getenv("SONARCLOUD_GITHUB_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if repository == "" {
+				t.Skip("SONARCLOUD_GITHUB_REPOSITORY must be set to test GitHub bindings")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectGithubBindingConfig(projectKey, repository, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "repository", repository),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "monorepo", "false"),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "summary_comment_enabled", "true"),
+				),
+			},
+			projectGithubBindingImportCheck("sonarcloud_project_github_binding.test", projectKey),
+			{
+				Config: testAccProjectGithubBindingConfig(projectKey, repository, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "monorepo", "true"),
+				),
+			},
+			projectGithubBindingImportCheck("sonarcloud_project_github_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectGithubBindingDestroy,
+	})
+}
+
+func testAccProjectGithubBindingDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectGithubBindingConfig(projectKey, repository string, monorepo bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_github_binding" "test" {
+	project_key = "%s"
+	repository  = "%s"
+	monorepo    = %t
+}
+`, projectKey, repository, monorepo)
+}
+
+func projectGithubBindingImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
@@ -0,0 +1,241 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectGitlabBindingType struct{}
+
+func (r resourceProjectGitlabBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource binds a project to a GitLab repository. The binding enables merge request " +
+			"decoration. The organization must be bound to the GitLab group of the repository.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the project.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 400),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"repository": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ID of the GitLab project that contains the repository.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 256),
+				},
+			},
+			"monorepo": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the repository is a monorepo that contains multiple projects. Defaults to `false`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectGitlabBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectGitlabBinding{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectGitlabBinding struct {
+	p provider
+}
+
+func (r resourceProjectGitlabBinding) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectGitlabBinding
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := sonarcloud.Post(r.p.client, "/alm_settings/set_gitlab_binding", r.setRequest(plan)); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the GitLab binding",
+			fmt.Sprintf("The SetGitlabBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readProjectGitlabBinding(r.p.client, plan.ProjectKey.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the GitLab binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the GitLab binding",
+			fmt.Sprintf("The project '%s' is not bound to a GitLab repository after creating the binding.", plan.ProjectKey.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectGitlabBinding) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state ProjectGitlabBinding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readProjectGitlabBinding(r.p.client, state.ProjectKey.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the GitLab binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceProjectGitlabBinding) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan ProjectGitlabBinding
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the binding again overwrites the existing binding
+	if err := sonarcloud.Post(r.p.client, "/alm_settings/set_gitlab_binding", r.setRequest(plan)); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the GitLab binding",
+			fmt.Sprintf("The SetGitlabBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result, ok, err := readProjectGitlabBinding(r.p.client, plan.ProjectKey.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the GitLab binding",
+			fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the GitLab binding",
+			fmt.Sprintf("The project '%s' is not bound to a GitLab repository after updating the binding.", plan.ProjectKey.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectGitlabBinding) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ProjectGitlabBinding
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteAlmBinding(r.p.client, r.p.organization, state.ProjectKey.Value); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not delete the GitLab binding",
+			fmt.Sprintf("The DeleteBinding request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectGitlabBinding) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// setRequest returns the request to bind the project to the planned repository, using the default for an unknown monorepo flag
+func (r resourceProjectGitlabBinding) setRequest(plan ProjectGitlabBinding) GitlabBindingSetRequest {
+	return GitlabBindingSetRequest{
+		Monorepo:     !plan.Monorepo.Unknown && plan.Monorepo.Value,
+		Organization: r.p.organization,
+		Project:      plan.ProjectKey.Value,
+		Repository:   plan.Repository.Value,
+	}
+}
+
+type GitlabBindingSetRequest struct {
+	Monorepo     bool   `form:"monorepo"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+	Repository   string `form:"repository,omitempty"`
+}
+
+// readProjectGitlabBinding returns the GitLab binding of the project with the given key, if it is bound to GitLab
+func readProjectGitlabBinding(client *sonarcloud.Client, projectKey string) (ProjectGitlabBinding, bool, error) {
+	response, ok, err := readAlmBinding(client, projectKey, "gitlab")
+	if err != nil || !ok {
+		return ProjectGitlabBinding{}, false, err
+	}
+
+	return ProjectGitlabBinding{
+		ID:         types.String{Value: projectKey},
+		ProjectKey: types.String{Value: projectKey},
+		Repository: types.String{Value: response.Repository},
+		Monorepo:   types.Bool{Value: response.Monorepo},
+	}, true, nil
+}
@@ -0,0 +1,66 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccProjectGitlabBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	repository := os.Getenv("SONARCLOUD_GITLAB_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if repository == "" {
+				t.Skip("SONARCLOUD_GITLAB_REPOSITORY must be set to test GitLab bindings")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectGitlabBindingConfig(projectKey, repository, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_gitlab_binding.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_gitlab_binding.test", "repository", repository),
+					resource.TestCheckResourceAttr("sonarcloud_project_gitlab_binding.test", "monorepo", "false"),
+				),
+			},
+			projectGitlabBindingImportCheck("sonarcloud_project_gitlab_binding.test", projectKey),
+			{
+				Config: testAccProjectGitlabBindingConfig(projectKey, repository, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_gitlab_binding.test", "monorepo", "true"),
+				),
+			},
+			projectGitlabBindingImportCheck("sonarcloud_project_gitlab_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectGitlabBindingDestroy,
+	})
+}
+
+func testAccProjectGitlabBindingDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectGitlabBindingConfig(projectKey, repository string, monorepo bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_gitlab_binding" "test" {
+	project_key = "%s"
+	repository  = "%s"
+	monorepo    = %t
+}
+`, projectKey, repository, monorepo)
+}
+
+func projectGitlabBindingImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
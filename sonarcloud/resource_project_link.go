@@ -87,10 +87,11 @@ func (r resourceProjectLink) Create(ctx context.Context, req tfsdk.CreateResourc
 
 	res, err := r.p.client.ProjectLinks.Create(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the project link",
 			fmt.Sprintf("The Create request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -122,10 +123,15 @@ func (r resourceProjectLink) Read(ctx context.Context, req tfsdk.ReadResourceReq
 
 	response, err := r.p.client.ProjectLinks.Search(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project link",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -155,10 +161,11 @@ func (r resourceProjectLink) Delete(ctx context.Context, req tfsdk.DeleteResourc
 	}
 	err := r.p.client.ProjectLinks.Delete(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the project link",
 			fmt.Sprintf("The Delete request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
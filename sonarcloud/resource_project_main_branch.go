@@ -85,10 +85,11 @@ func (r resourceProjectMainBranch) Create(ctx context.Context, req tfsdk.CreateR
 
 	err := r.p.client.ProjectBranches.Rename(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the main project branch",
 			fmt.Sprintf("The Rename request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -118,10 +119,15 @@ func (r resourceProjectMainBranch) Read(ctx context.Context, req tfsdk.ReadResou
 
 	response, err := r.p.client.ProjectBranches.List(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the project branches",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -151,7 +157,8 @@ func (r resourceProjectMainBranch) Update(ctx context.Context, req tfsdk.UpdateR
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed, diags := changedAttrs(req)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,10 +176,11 @@ func (r resourceProjectMainBranch) Update(ctx context.Context, req tfsdk.UpdateR
 
 	err := r.p.client.ProjectBranches.Rename(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not update the main project branch",
 			fmt.Sprintf("The Rename request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,285 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectNewCodePeriodType struct{}
+
+func (r resourceProjectNewCodePeriodType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the new code definition of a project, or of a single branch of a project.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, in the format `project_key` or `project_key,branch`.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 400),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"branch": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the branch. If not set, the new code definition applies to the whole project.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 255),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"type": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The type of the new code definition. Must be one of `PREVIOUS_VERSION`, `NUMBER_OF_DAYS` or `REFERENCE_BRANCH`.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("PREVIOUS_VERSION", "NUMBER_OF_DAYS", "REFERENCE_BRANCH"),
+				},
+			},
+			"value": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The value of the new code definition: the number of days for `NUMBER_OF_DAYS` or the name of the " +
+					"branch for `REFERENCE_BRANCH`. Must not be set for `PREVIOUS_VERSION`.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectNewCodePeriodType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectNewCodePeriod{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectNewCodePeriod struct {
+	p provider
+}
+
+func (r resourceProjectNewCodePeriod) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		newCodePeriod(),
+	}
+}
+
+func (r resourceProjectNewCodePeriod) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectNewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodSetRequest{
+		Branch:       plan.Branch.Value,
+		Organization: r.p.organization,
+		Project:      plan.ProjectKey.Value,
+		Type:         plan.Type.Value,
+		Value:        plan.Value.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the new code period",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// We have no response, assume the new code period was set when no error has been returned and just set ID
+	state := plan
+	state.ID = types.String{Value: projectNewCodePeriodID(plan.ProjectKey.Value, plan.Branch.Value)}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectNewCodePeriod) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state ProjectNewCodePeriod
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := readNewCodePeriod(r.p.client, state.ProjectKey.Value, state.Branch.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the new code period",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// An inherited new code period means that it is not set on the project (or branch) itself anymore
+	if response.Inherited {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := ProjectNewCodePeriod{
+		ID:         types.String{Value: projectNewCodePeriodID(state.ProjectKey.Value, state.Branch.Value)},
+		ProjectKey: state.ProjectKey,
+		Branch:     state.Branch,
+		Type:       types.String{Value: response.Type},
+		Value:      newCodePeriodValue(response.Value),
+	}
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectNewCodePeriod) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan ProjectNewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodSetRequest{
+		Branch:       plan.Branch.Value,
+		Organization: r.p.organization,
+		Project:      plan.ProjectKey.Value,
+		Type:         plan.Type.Value,
+		Value:        plan.Value.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the new code period",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = types.String{Value: projectNewCodePeriodID(plan.ProjectKey.Value, plan.Branch.Value)}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectNewCodePeriod) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ProjectNewCodePeriod
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodUnsetRequest{
+		Branch:       state.Branch.Value,
+		Organization: r.p.organization,
+		Project:      state.ProjectKey.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/new_code_periods/unset", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not unset the new code period",
+			fmt.Sprintf("The Unset request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectNewCodePeriod) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key or project_key,branch. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), idParts[1])...)
+	}
+}
+
+type NewCodePeriodSetRequest struct {
+	Branch       string `form:"branch,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+	Type         string `form:"type,omitempty"`
+	Value        string `form:"value,omitempty"`
+}
+
+type NewCodePeriodUnsetRequest struct {
+	Branch       string `form:"branch,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+}
+
+type NewCodePeriodShowResponse struct {
+	ProjectKey string `json:"projectKey,omitempty"`
+	BranchKey  string `json:"branchKey,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Value      string `json:"value,omitempty"`
+	Inherited  bool   `json:"inherited,omitempty"`
+}
+
+// projectNewCodePeriodID returns the ID of a project new code period, which equals its import identifier
+func projectNewCodePeriodID(projectKey, branch string) string {
+	if branch == "" {
+		return projectKey
+	}
+	return fmt.Sprintf("%s,%s", projectKey, branch)
+}
+
+// readNewCodePeriod returns the new code period of the given project and branch, or of the organization if both are empty
+func readNewCodePeriod(client *sonarcloud.Client, projectKey, branch string) (*NewCodePeriodShowResponse, error) {
+	var params []string
+	if projectKey != "" {
+		params = append(params, "project", projectKey)
+	}
+	if branch != "" {
+		params = append(params, "branch", branch)
+	}
+	return getWithResponse[NewCodePeriodShowResponse](client, "/new_code_periods/show", params...)
+}
+
+// newCodePeriodValue converts the value of a new code period, which is empty for PREVIOUS_VERSION
+func newCodePeriodValue(value string) types.String {
+	if value == "" {
+		return types.String{Null: true}
+	}
+	return types.String{Value: value}
+}
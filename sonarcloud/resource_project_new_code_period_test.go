@@ -0,0 +1,62 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccProjectNewCodePeriod(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectNewCodePeriodConfig(projectKey, "NUMBER_OF_DAYS", `"30"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_new_code_period.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_new_code_period.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_new_code_period.test", "type", "NUMBER_OF_DAYS"),
+					resource.TestCheckResourceAttr("sonarcloud_project_new_code_period.test", "value", "30"),
+				),
+			},
+			projectNewCodePeriodImportCheck("sonarcloud_project_new_code_period.test", projectKey),
+			{
+				Config: testAccProjectNewCodePeriodConfig(projectKey, "PREVIOUS_VERSION", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_new_code_period.test", "type", "PREVIOUS_VERSION"),
+					resource.TestCheckNoResourceAttr("sonarcloud_project_new_code_period.test", "value"),
+				),
+			},
+			projectNewCodePeriodImportCheck("sonarcloud_project_new_code_period.test", projectKey),
+		},
+		CheckDestroy: testAccProjectNewCodePeriodDestroy,
+	})
+}
+
+func testAccProjectNewCodePeriodDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectNewCodePeriodConfig(projectKey, periodType, value string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_new_code_period" "test" {
+	project_key = "%s"
+	type        = "%s"
+	value       = %s
+}
+`, projectKey, periodType, value)
+}
+
+func projectNewCodePeriodImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
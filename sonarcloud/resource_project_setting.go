@@ -0,0 +1,329 @@
+package sonarcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/settings"
+)
+
+type resourceProjectSettingType struct{}
+
+func (r resourceProjectSettingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a single setting of a project, e.g. `sonar.exclusions`." +
+			" Exactly one of `value`, `values` or `field_values` must be set, depending on the type of the setting.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, in the format `project_key,key`.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project to set the setting for.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the setting, e.g. `sonar.coverage.exclusions`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"value": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The value of a scalar setting.",
+			},
+			"values": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The values of a multi-value setting, e.g. a list of exclusion patterns.",
+			},
+			"field_values": {
+				Type:     types.ListType{ElemType: types.MapType{ElemType: types.StringType}},
+				Optional: true,
+				Description: "The field values of a property set setting, e.g. `sonar.issue.ignore.multicriteria`." +
+					" Each entry maps the field names of the setting to their values.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectSettingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectSetting{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectSetting struct {
+	p provider
+}
+
+func (r resourceProjectSetting) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		exactlyOneOf(path.Root("value"), path.Root("values"), path.Root("field_values")),
+	}
+}
+
+func (r resourceProjectSetting) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectSetting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := projectSettingSetRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := sonarcloud.Post(r.p.client, "/settings/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the project setting",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// We have no response, assume the values were set when no error has been returned and just set ID
+	state := plan
+	state.ID = types.String{Value: projectSettingID(plan.ProjectKey.Value, plan.Key.Value)}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectSetting) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state ProjectSetting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWithResponse[ProjectSettingValuesResponse](r.p.client, "/settings/values",
+		"component", state.ProjectKey.Value,
+		"keys", state.Key.Value,
+	)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the project setting",
+			fmt.Sprintf("The Values request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// Check if the resource exists the list of retrieved resources
+	if result, ok := findProjectSetting(response, state.ProjectKey.Value, state.Key.Value); ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceProjectSetting) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan ProjectSetting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := projectSettingSetRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := sonarcloud.Post(r.p.client, "/settings/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the project setting",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = types.String{Value: projectSettingID(plan.ProjectKey.Value, plan.Key.Value)}
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectSetting) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ProjectSetting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := settings.ResetRequest{
+		Component: state.ProjectKey.Value,
+		Keys:      state.Key.Value,
+	}
+	if err := r.p.client.Settings.Reset(request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not reset the project setting",
+			fmt.Sprintf("The Reset request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectSetting) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key,setting_key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[1])...)
+}
+
+// ProjectSettingSetRequest is used instead of settings.SetRequest, because that one does not support multiple values
+type ProjectSettingSetRequest struct {
+	Component   string   `form:"component,omitempty"`
+	FieldValues []string `form:"fieldValues,omitempty"`
+	Key         string   `form:"key,omitempty"`
+	Value       string   `form:"value,omitempty"`
+	Values      []string `form:"values,omitempty"`
+}
+
+type ProjectSettingValuesResponse struct {
+	Settings []ProjectSettingValuesResponseSetting `json:"settings"`
+}
+
+type ProjectSettingValuesResponseSetting struct {
+	Key         string              `json:"key,omitempty"`
+	Value       string              `json:"value,omitempty"`
+	Values      []string            `json:"values,omitempty"`
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
+	Inherited   bool                `json:"inherited,omitempty"`
+}
+
+// projectSettingID returns the ID of a project setting, which equals its import identifier
+func projectSettingID(projectKey, key string) string {
+	return fmt.Sprintf("%s,%s", projectKey, key)
+}
+
+// projectSettingSetRequest returns the request to set the value(s) of the planned project setting
+func projectSettingSetRequest(ctx context.Context, plan ProjectSetting) (ProjectSettingSetRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := ProjectSettingSetRequest{
+		Component: plan.ProjectKey.Value,
+		Key:       plan.Key.Value,
+		Value:     plan.Value.Value,
+	}
+
+	if !plan.Values.Null {
+		diags.Append(plan.Values.ElementsAs(ctx, &request.Values, false)...)
+	}
+
+	if !plan.FieldValues.Null {
+		var fieldValues []map[string]string
+		diags.Append(plan.FieldValues.ElementsAs(ctx, &fieldValues, false)...)
+
+		for _, fields := range fieldValues {
+			encoded, err := json.Marshal(fields)
+			if err != nil {
+				diags.AddError(
+					"Could not encode the field values",
+					fmt.Sprintf("The field values could not be encoded as JSON: %+v", err),
+				)
+				continue
+			}
+			request.FieldValues = append(request.FieldValues, string(encoded))
+		}
+	}
+
+	return request, diags
+}
+
+// findProjectSetting returns the setting with the given key if it is set on the project itself (i.e. not inherited)
+func findProjectSetting(response *ProjectSettingValuesResponse, projectKey, key string) (ProjectSetting, bool) {
+	var result ProjectSetting
+	ok := false
+	for _, s := range response.Settings {
+		if s.Key != key || s.Inherited {
+			continue
+		}
+
+		result = ProjectSetting{
+			ID:          types.String{Value: projectSettingID(projectKey, key)},
+			ProjectKey:  types.String{Value: projectKey},
+			Key:         types.String{Value: s.Key},
+			Value:       types.String{Null: true},
+			Values:      types.List{ElemType: types.StringType, Null: true},
+			FieldValues: types.List{ElemType: types.MapType{ElemType: types.StringType}, Null: true},
+		}
+
+		switch {
+		case s.FieldValues != nil:
+			elems := make([]attr.Value, len(s.FieldValues))
+			for i, fields := range s.FieldValues {
+				fieldElems := make(map[string]attr.Value, len(fields))
+				for k, v := range fields {
+					fieldElems[k] = types.String{Value: v}
+				}
+				elems[i] = types.Map{ElemType: types.StringType, Elems: fieldElems}
+			}
+			result.FieldValues = types.List{ElemType: types.MapType{ElemType: types.StringType}, Elems: elems}
+		case s.Values != nil:
+			elems := make([]attr.Value, len(s.Values))
+			for i, v := range s.Values {
+				elems[i] = types.String{Value: v}
+			}
+			result.Values = types.List{ElemType: types.StringType, Elems: elems}
+		default:
+			result.Value = types.String{Value: s.Value}
+		}
+
+		ok = true
+		break
+	}
+	return result, ok
+}
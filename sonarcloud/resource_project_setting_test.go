@@ -0,0 +1,101 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAccProjectSetting(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectSettingValueConfig(projectKey, "sonar.cpd.exclusions", "**/*.generated.go"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "key", "sonar.cpd.exclusions"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "value", "**/*.generated.go"),
+				),
+			},
+			projectSettingImportCheck("sonarcloud_project_setting.test"),
+			{
+				Config: testAccProjectSettingValuesConfig(projectKey, "sonar.exclusions", []string{"**/vendor/**", "**/testdata/**"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "key", "sonar.exclusions"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.0", "**/vendor/**"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.1", "**/testdata/**"),
+				),
+			},
+			projectSettingImportCheck("sonarcloud_project_setting.test"),
+			{
+				Config: testAccProjectSettingFieldValuesConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "key", "sonar.issue.ignore.multicriteria"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "field_values.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "field_values.0.ruleKey", "go:S100"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "field_values.0.resourceKey", "**/*_test.go"),
+				),
+			},
+			projectSettingImportCheck("sonarcloud_project_setting.test"),
+		},
+		CheckDestroy: testAccProjectSettingDestroy,
+	})
+}
+
+func testAccProjectSettingDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectSettingValueConfig(projectKey, key, value string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_setting" "test" {
+	project_key = "%s"
+	key         = "%s"
+	value       = "%s"
+}
+`, projectKey, key, value)
+}
+
+func testAccProjectSettingValuesConfig(projectKey, key string, values []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_setting" "test" {
+	project_key = "%s"
+	key         = "%s"
+	values      = %s
+}
+`, projectKey, key, fmt.Sprintf(`["%s"]`, strings.Join(values, `","`)))
+}
+
+func testAccProjectSettingFieldValuesConfig(projectKey string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_setting" "test" {
+	project_key  = "%s"
+	key          = "sonar.issue.ignore.multicriteria"
+	field_values = [
+		{
+			ruleKey     = "go:S100"
+			resourceKey = "**/*_test.go"
+		}
+	]
+}
+`, projectKey)
+}
+
+func projectSettingImportCheck(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName: resourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(state *terraform.State) (string, error) {
+			return state.RootModule().Resources[resourceName].Primary.ID, nil
+		},
+		ImportStateVerify: true,
+	}
+}
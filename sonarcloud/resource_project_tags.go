@@ -0,0 +1,202 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectTagsType struct{}
+
+func (r resourceProjectTagsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the tags of a project. The tags are used to group projects, e.g. by team or domain.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the key of the project.",
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 400),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"tags": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The tags of the project. Tags are converted to lowercase by SonarCloud, so they must be lowercase.",
+				Validators: []tfsdk.AttributeValidator{
+					lowercaseSetElements(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectTagsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectTags{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectTags struct {
+	p provider
+}
+
+func (r resourceProjectTags) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectTags
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := ProjectTagsSetRequest{
+		Project: plan.ProjectKey.Value,
+		Tags:    commaSeparatedSet(plan.Tags),
+	}
+	if err := sonarcloud.Post(r.p.client, "/project_tags/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not set the project tags",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// We have no response, assume the tags were set when no error has been returned and just set ID
+	state := plan
+	state.ID = plan.ProjectKey
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectTags) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state ProjectTags
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWithResponse[ProjectTagsComponentShowResponse](r.p.client, "/components/show",
+		"component", state.ProjectKey.Value,
+	)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the project tags",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	result := ProjectTags{
+		ID:         types.String{Value: response.Component.Key},
+		ProjectKey: types.String{Value: response.Component.Key},
+		Tags:       stringSetOf(response.Component.Tags),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectTags) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from plan
+	var plan ProjectTags
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := ProjectTagsSetRequest{
+		Project: plan.ProjectKey.Value,
+		Tags:    commaSeparatedSet(plan.Tags),
+	}
+	if err := sonarcloud.Post(r.p.client, "/project_tags/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not update the project tags",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	state := plan
+	state.ID = plan.ProjectKey
+	diags = resp.State.Set(ctx, state)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectTags) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ProjectTags
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting an empty list of tags removes all tags from the project
+	request := ProjectTagsSetRequest{
+		Project: state.ProjectKey.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/project_tags/set", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not remove the project tags",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectTags) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// ProjectTagsSetRequest is used instead of project_tags.SetRequest, because the tags must also be sent when empty
+type ProjectTagsSetRequest struct {
+	Project string `form:"project,omitempty"`
+	Tags    string `form:"tags"`
+}
+
+// ProjectTagsComponentShowResponse is used instead of components.ShowResponse, because that one does not contain the tags
+type ProjectTagsComponentShowResponse struct {
+	Component struct {
+		Key  string   `json:"key,omitempty"`
+		Name string   `json:"name,omitempty"`
+		Tags []string `json:"tags,omitempty"`
+	} `json:"component,omitempty"`
+}
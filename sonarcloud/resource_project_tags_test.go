@@ -0,0 +1,76 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAccProjectTags(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTagsConfig(projectKey, []string{"team-a", "backend"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_tags.test", "id", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_tags.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_tags.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project_tags.test", "tags.*", "team-a"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project_tags.test", "tags.*", "backend"),
+				),
+			},
+			projectTagsImportCheck("sonarcloud_project_tags.test", projectKey),
+			{
+				Config: testAccProjectTagsConfig(projectKey, []string{"team-b"}) + testAccProjectTagsDataSourceConfig("team-b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_tags.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project_tags.test", "tags.*", "team-b"),
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test", "projects.0.key", projectKey),
+					resource.TestCheckTypeSetElemAttr("data.sonarcloud_projects.test", "projects.0.tags.*", "team-b"),
+				),
+			},
+			projectTagsImportCheck("sonarcloud_project_tags.test", projectKey),
+		},
+		CheckDestroy: testAccProjectTagsDestroy,
+	})
+}
+
+func testAccProjectTagsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectTagsConfig(projectKey string, tags []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_tags" "test" {
+	project_key = "%s"
+	tags        = ["%s"]
+}
+`, projectKey, strings.Join(tags, `","`))
+}
+
+func testAccProjectTagsDataSourceConfig(tag string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_projects" "test" {
+	tags = ["%s"]
+
+	depends_on = [sonarcloud_project_tags.test]
+}
+`, tag)
+}
+
+func projectTagsImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
@@ -2,9 +2,13 @@ package sonarcloud
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -47,6 +51,14 @@ func TestAccResourceProject(t *testing.T) {
 				),
 			},
 			projectImportCheck("sonarcloud_project.test", keys[1]),
+			{
+				Config: testAccProjectAutomaticAnalysisConfig(names[1], keys[1], visibilities[0], false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "key", keys[1]),
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "automatic_analysis", "false"),
+				),
+			},
+			projectImportCheck("sonarcloud_project.test", keys[1]),
 		},
 		CheckDestroy: testAccProjectDestroy,
 	})
@@ -66,6 +78,17 @@ resource "sonarcloud_project" "test" {
 `, name, key, visibility)
 }
 
+func testAccProjectAutomaticAnalysisConfig(name, key, visibility string, automaticAnalysis bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project" "test" {
+	name = "%s"
+	key = "%s"
+	visibility = "%s"
+	automatic_analysis = %t
+}
+`, name, key, visibility, automaticAnalysis)
+}
+
 func projectImportCheck(resourceName, key string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      resourceName,
@@ -74,3 +97,69 @@ func projectImportCheck(resourceName, key string) resource.TestStep {
 		ImportStateVerify: true,
 	}
 }
+
+func TestReadAutomaticAnalysis(t *testing.T) {
+	current := types.Bool{Value: true}
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    types.Bool
+		wantErr bool
+	}{
+		{
+			name:   "enabled",
+			status: http.StatusOK,
+			body:   `{"autoscanEnabled":true}`,
+			want:   types.Bool{Value: true},
+		},
+		{
+			name:   "disabled",
+			status: http.StatusOK,
+			body:   `{"autoscanEnabled":false}`,
+			want:   types.Bool{Value: false},
+		},
+		{
+			name:   "not eligible",
+			status: http.StatusNotFound,
+			body:   `{"errors":[{"msg":"Project 'my-project' is not eligible for autoscan"}]}`,
+			want:   current,
+		},
+		{
+			name:    "forbidden",
+			status:  http.StatusForbidden,
+			body:    `{"errors":[{"msg":"Insufficient privileges"}]}`,
+			want:    current,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/autoscan/eligibility" || r.URL.Query().Get("projectKey") != "my-project" {
+					t.Errorf("unexpected request: %s", r.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(server.Client(), server.URL+"/api")
+			if err != nil {
+				t.Fatalf("could not create http client: %+v", err)
+			}
+			client := sonarcloud.NewClient("my-org", "token", httpClient)
+
+			got, err := readAutomaticAnalysis(client, "my-project", current)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got: %+v", tt.wantErr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got: %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -45,8 +47,7 @@ func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 			},
 			"is_default": {
 				Type:        types.BoolType,
-				Description: "Defines whether the quality gate is the default gate for an organization. **WARNING**: Must be assigned to one quality gate per organization at all times.",
-				Optional:    true,
+				Description: "Defines whether the quality gate is the default gate for an organization. Use the `sonarcloud_default_quality_gate` resource to change the default gate.",
 				Computed:    true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
@@ -133,10 +134,11 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 
 	res, err := r.p.client.Qualitygates.Create(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the Quality Gate",
 			fmt.Sprintf("The Quality Gate create request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -146,20 +148,6 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 		Name:   types.String{Value: res.Name},
 	}
 
-	if plan.IsDefault.Value {
-		setDefualtRequest := qualitygates.SetAsDefaultRequest{
-			Id:           fmt.Sprintf("%d", int(result.GateId.Value)),
-			Organization: r.p.organization,
-		}
-		err := r.p.client.Qualitygates.SetAsDefault(setDefualtRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not set Quality Gate as default",
-				fmt.Sprintf("The Quality Gate SetAsDefault request returned an error: %+v", err),
-			)
-		}
-	}
-
 	conditionRequests := qualitygates.CreateConditionRequest{}
 	for _, conditionPlan := range plan.Conditions {
 		conditionRequests = qualitygates.CreateConditionRequest{
@@ -171,10 +159,11 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 		}
 		res, err := r.p.client.Qualitygates.CreateCondition(conditionRequests)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Could not create a Condition",
 				fmt.Sprintf("The Condition Create Request returned an error: %+v", err),
-			)
+				err,
+			))
 			return
 		}
 		// didn't implement warning
@@ -193,14 +182,15 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 
 	listRes, err := r.p.client.Qualitygates.List(listRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the Quality Gate",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
-	if createdQualityGate, ok := findQualityGate(listRes, result.Name.Value); ok {
+	if createdQualityGate, ok := findQualityGateById(listRes, result.GateId.Value); ok {
 		result.IsBuiltIn = createdQualityGate.IsBuiltIn
 		result.IsDefault = createdQualityGate.IsDefault
 	}
@@ -225,15 +215,29 @@ func (r resourceQualityGate) Read(ctx context.Context, req tfsdk.ReadResourceReq
 
 	response, err := r.p.client.Qualitygates.List(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the Quality Gate(s)",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
-	// Check if the resource exists in the list of retrieved resources
-	if result, ok := findQualityGate(response, state.Name.Value); ok {
+	// Check if the resource exists in the list of retrieved resources. The gate is looked up by its ID first, so it
+	// survives a rename outside of Terraform. The ID is only unknown right after an import by name.
+	var result QualityGate
+	var ok bool
+	if !state.GateId.Null && !state.GateId.Unknown {
+		result, ok = findQualityGateById(response, state.GateId.Value)
+	} else {
+		result, ok = findQualityGate(response, state.Name.Value)
+	}
+
+	if ok {
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -272,47 +276,15 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 
 		err := r.p.client.Qualitygates.Rename(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Could not update Quality Gate Name.",
 				fmt.Sprintf("The Rename request returned an error: %+v", err),
-			)
+				err,
+			))
 			return
 		}
 	}
 
-	if diffDefault(state, plan) {
-		if plan.IsDefault.Equal(types.Bool{Value: true}) {
-			request := qualitygates.SetAsDefaultRequest{
-				Id:           fmt.Sprintf("%d", int(state.GateId.Value)),
-				Organization: r.p.organization,
-			}
-			err := r.p.client.Qualitygates.SetAsDefault(request)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Could not set Quality Gate as Default.",
-					fmt.Sprintf("The SetAsDefault request returned an error %+v", err),
-				)
-				return
-			}
-		}
-		// Hard coded default present in all repositories (Sonar way)
-		// This assumes that the Sonar way default quality gate will
-		// never change its ID and remain the default forever.
-		if plan.IsDefault.Equal(types.Bool{Value: false}) {
-			request := qualitygates.SetAsDefaultRequest{
-				Id:           "9",
-				Organization: r.p.organization,
-			}
-			err := r.p.client.Qualitygates.SetAsDefault(request)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Could not set `Sonar Way` quality gate to default",
-					fmt.Sprintf("The SetAsDefault request returned an error %+v", err),
-				)
-			}
-		}
-	}
-
 	toCreate, toUpdate, toRemove := diffConditions(state.Conditions, plan.Conditions)
 
 	if len(toUpdate) > 0 {
@@ -327,10 +299,11 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 
 			err := r.p.client.Qualitygates.UpdateCondition(request)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				resp.Diagnostics.Append(apiErrorDiagnostic(
 					"Could not update QualityGate condition",
 					fmt.Sprintf("The UpdateCondition request returned an error %+v", err),
-				)
+					err,
+				))
 				return
 			}
 		}
@@ -346,10 +319,11 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 			}
 			_, err := r.p.client.Qualitygates.CreateCondition(request)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				resp.Diagnostics.Append(apiErrorDiagnostic(
 					"Could not create QualityGate condition",
 					fmt.Sprintf("The CreateCondition request returned an error %+v", err),
-				)
+					err,
+				))
 				return
 			}
 		}
@@ -362,10 +336,11 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 			}
 			err := r.p.client.Qualitygates.DeleteCondition(request)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				resp.Diagnostics.Append(apiErrorDiagnostic(
 					"Could not delete QualityGate condition",
 					fmt.Sprintf("The DeleteCondition request returned an error %+v", err),
-				)
+					err,
+				))
 				return
 			}
 		}
@@ -377,14 +352,15 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 
 	response, err := r.p.client.Qualitygates.List(listRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the Quality Gate",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
-	if result, ok := findQualityGate(response, plan.Name.Value); ok {
+	if result, ok := findQualityGateById(response, state.GateId.Value); ok {
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	}
@@ -399,20 +375,39 @@ func (r resourceQualityGate) Delete(ctx context.Context, req tfsdk.DeleteResourc
 		return
 	}
 
-	// Hard coded default present in all repositories (Sonar way)
-	// This assumes that the Sonar way default quality gate will
-	// never change its ID and remain the default forever.
+	// The default quality gate can not be destroyed, so the built-in quality gate (Sonar way) is made the default first
 	if state.IsDefault.Equal(types.Bool{Value: true}) {
+		response, err := r.p.client.Qualitygates.List(qualitygates.ListRequest{Organization: r.p.organization})
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not read the quality gates pre-delete",
+				fmt.Sprintf("The List request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+
+		builtInId, ok := findBuiltInQualityGate(response)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Could not reset Organization's default quality gate pre-delete",
+				"No built-in quality gate was found to use as default.",
+			)
+			return
+		}
+
 		request := qualitygates.SetAsDefaultRequest{
-			Id:           "9",
+			Id:           builtInId,
 			Organization: r.p.organization,
 		}
-		err := r.p.client.Qualitygates.SetAsDefault(request)
+		err = r.p.client.Qualitygates.SetAsDefault(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Could not reset Organization's default quality gate pre-delete",
 				fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
-			)
+				err,
+			))
+			return
 		}
 	}
 
@@ -423,16 +418,28 @@ func (r resourceQualityGate) Delete(ctx context.Context, req tfsdk.DeleteResourc
 
 	err := r.p.client.Qualitygates.Destroy(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not destroy the quality gate",
 			fmt.Sprintf("The Destroy request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 	resp.State.RemoveResource(ctx)
 }
 
 func (r resourceQualityGate) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Gates can be imported by either their numeric ID or their name. A numeric value is always read as an ID, so gates
+	// with a numeric name are imported with the "name:" prefix.
+	if name := strings.TrimPrefix(req.ID, "name:"); name != req.ID {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+		return
+	}
+	if id, err := strconv.Atoi(req.ID); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gate_id"), float64(id))...)
+		return
+	}
+
 	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
@@ -444,24 +451,20 @@ func diffName(old, new QualityGate) bool {
 	return true
 }
 
-//Check if a Quality Gate has been set to default
-func diffDefault(old, new QualityGate) bool {
-	if old.IsDefault.Equal(new.IsDefault) {
-		return false
-	}
-	return true
-}
-
 // Check if Quality Gate Conditions are different
+// Conditions are matched on their metric. Only the conditions of which the error or op changed are updated, with the ID
+// of the existing condition, because the ID of a changed condition is unknown in the plan.
 func diffConditions(old, new []Condition) (create, update, remove []Condition) {
 	create = []Condition{}
 	remove = []Condition{}
 	update = []Condition{}
 
 	for _, c := range new {
-		if !containsCondition(old, c) {
+		existing, ok := conditionWithMetric(old, c.Metric)
+		if !ok {
 			create = append(create, c)
-		} else {
+		} else if !existing.Error.Equal(c.Error) || !existing.Op.Equal(c.Op) {
+			c.ID = existing.ID
 			update = append(update, c)
 		}
 	}
@@ -476,10 +479,16 @@ func diffConditions(old, new []Condition) (create, update, remove []Condition) {
 
 // Check if a condition is contained in a condition list
 func containsCondition(list []Condition, item Condition) bool {
+	_, ok := conditionWithMetric(list, item.Metric)
+	return ok
+}
+
+// conditionWithMetric returns the first condition in the list with the given metric
+func conditionWithMetric(list []Condition, metric types.String) (Condition, bool) {
 	for _, c := range list {
-		if c.Metric.Equal(item.Metric) {
-			return true
+		if c.Metric.Equal(metric) {
+			return c, true
 		}
 	}
-	return false
+	return Condition{}, false
 }
@@ -67,21 +67,8 @@ func (r resourceQualityGateSelection) Create(ctx context.Context, req tfsdk.Crea
 		return
 	}
 
-	for _, s := range plan.ProjectKeys.Elems {
-		// Fill in api action struct for Quality Gates
-		request := qualitygates.SelectRequest{
-			GateId:       plan.GateId.Value,
-			ProjectKey:   s.(types.String).Value,
-			Organization: r.p.organization,
-		}
-		err := r.p.client.Qualitygates.Select(request)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not create Quality Gate Selection",
-				fmt.Sprintf("The Select request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.selectProjects(ctx, plan.GateId.Value, plan.ProjectKeys.Elems, &resp.Diagnostics) {
+		return
 	}
 
 	// Query for selection
@@ -92,10 +79,11 @@ func (r resourceQualityGateSelection) Create(ctx context.Context, req tfsdk.Crea
 
 	res, err := r.p.client.Qualitygates.Search(searchRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read Quality Gate Selection",
 			fmt.Sprintf("The Search request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -127,10 +115,15 @@ func (r resourceQualityGateSelection) Read(ctx context.Context, req tfsdk.ReadRe
 	}
 	res, err := r.p.client.Qualitygates.Search(searchRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not Read the Quality Gate Selection",
 			fmt.Sprintf("The Search request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 	if result, ok := findSelection(res, state.ProjectKeys.Elems); ok {
@@ -160,34 +153,11 @@ func (r resourceQualityGateSelection) Update(ctx context.Context, req tfsdk.Upda
 
 	sel, rem := diffSelection(state, plan)
 
-	for _, s := range rem {
-		deselectRequest := qualitygates.DeselectRequest{
-			Organization: r.p.organization,
-			ProjectKey:   s.(types.String).Value,
-		}
-		err := r.p.client.Qualitygates.Deselect(deselectRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not Deselect the Quality Gate selection",
-				fmt.Sprintf("The Deselect request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.deselectProjects(ctx, rem, &resp.Diagnostics) {
+		return
 	}
-	for _, s := range sel {
-		selectRequest := qualitygates.SelectRequest{
-			GateId:       state.GateId.Value,
-			Organization: r.p.organization,
-			ProjectKey:   s.(types.String).Value,
-		}
-		err := r.p.client.Qualitygates.Select(selectRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not Select the Quality Gate selection",
-				fmt.Sprintf("The Select request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.selectProjects(ctx, state.GateId.Value, sel, &resp.Diagnostics) {
+		return
 	}
 
 	request := qualitygates.SearchRequest{
@@ -196,10 +166,11 @@ func (r resourceQualityGateSelection) Update(ctx context.Context, req tfsdk.Upda
 	}
 	res, err := r.p.client.Qualitygates.Search(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not Read the Quality Gate Selection",
 			fmt.Sprintf("The Search request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 	if result, ok := findSelection(res, plan.ProjectKeys.Elems); ok {
@@ -224,22 +195,54 @@ func (r resourceQualityGateSelection) Delete(ctx context.Context, req tfsdk.Dele
 		return
 	}
 
-	for _, s := range state.ProjectKeys.Elems {
-		request := qualitygates.DeselectRequest{
+	if !r.deselectProjects(ctx, state.ProjectKeys.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// selectProjects selects the quality gate for the given projects, and returns whether all of them were selected
+func (r resourceQualityGateSelection) selectProjects(ctx context.Context, gateId string, projectKeys []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, projectKeys, func(projectKey attr.Value) error {
+		request := qualitygates.SelectRequest{
+			GateId:       gateId,
+			ProjectKey:   projectKey.(types.String).Value,
 			Organization: r.p.organization,
-			ProjectKey:   s.(types.String).Value,
 		}
-		err := r.p.client.Qualitygates.Deselect(request)
+		return r.p.client.Qualitygates.Select(request)
+	})
+	for i, err := range errs {
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not Deselect the Quality Gate Selection",
-				fmt.Sprintf("The Deselect request returned an error: %+v", err),
-			)
-			return
+			diags.Append(apiErrorDiagnostic(
+				"Could not Select the Quality Gate selection",
+				fmt.Sprintf("The Select request for project '%s' returned an error: %+v", projectKeys[i].(types.String).Value, err),
+				err,
+			))
 		}
 	}
+	return !diags.HasError()
+}
 
-	resp.State.RemoveResource(ctx)
+// deselectProjects deselects the quality gate of the given projects, and returns whether all of them were deselected
+func (r resourceQualityGateSelection) deselectProjects(ctx context.Context, projectKeys []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, projectKeys, func(projectKey attr.Value) error {
+		request := qualitygates.DeselectRequest{
+			Organization: r.p.organization,
+			ProjectKey:   projectKey.(types.String).Value,
+		}
+		return r.p.client.Qualitygates.Deselect(request)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not Deselect the Quality Gate selection",
+				fmt.Sprintf("The Deselect request for project '%s' returned an error: %+v", projectKeys[i].(types.String).Value, err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
 }
 
 func diffSelection(state, plan Selection) (sel, rem []attr.Value) {
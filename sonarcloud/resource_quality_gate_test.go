@@ -10,10 +10,10 @@ import (
 
 func TestAccResourceQualityGate(t *testing.T) {
 	names := []string{"quality_gate_a", "quality_gate_b"}
-	def := []string{"true", "false"}
 	metrics := []string{"coverage", "duplicated_lines_density"}
 	testError := []string{"10", "11"}
 	Op := []string{"LT", "GT"}
+	var gateId string
 
 	// TODO: use fixed test organization so that changes can be verified.
 
@@ -22,25 +22,32 @@ func TestAccResourceQualityGate(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccQualityGateConfig(names[0], def[0], metrics[0], testError[0], Op[0]),
+				Config: testAccQualityGateConfig(names[0], metrics[0], testError[0], Op[0]),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "name", names[0]),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "is_default", "false"),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.metric", metrics[0]),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.error", testError[0]),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.op", Op[0]),
+					testAccQualityGateId("sonarcloud_quality_gate.test", &gateId),
 				),
 			},
 			qualityGateImportCheck("sonarcloud_quality_gate.test", names[0]),
+			qualityGateImportCheck("sonarcloud_quality_gate.test", "name:"+names[0]),
+			qualityGateImportByIdCheck("sonarcloud_quality_gate.test"),
 			{
-				Config: testAccQualityGateConfig(names[1], def[1], metrics[1], testError[1], Op[1]),
+				Config: testAccQualityGateConfig(names[1], metrics[1], testError[1], Op[1]),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "name", names[1]),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.metric", metrics[1]),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.error", testError[1]),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.op", Op[1]),
+					// The rename must not replace the gate
+					resource.TestCheckResourceAttrPtr("sonarcloud_quality_gate.test", "id", &gateId),
 				),
 			},
 			qualityGateImportCheck("sonarcloud_quality_gate.test", names[1]),
+			qualityGateImportByIdCheck("sonarcloud_quality_gate.test"),
 		},
 		CheckDestroy: testAccQualityGateDestroy,
 	})
@@ -50,11 +57,10 @@ func testAccQualityGateDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccQualityGateConfig(name, def, metric, err, op string) string {
+func testAccQualityGateConfig(name, metric, err, op string) string {
 	return fmt.Sprintf(`
 resource "sonarcloud_quality_gate" "test" {
 	name = "%s"
-	is_default = "%s"
 	conditions = [
 		{
 			metric = "%s"
@@ -63,7 +69,7 @@ resource "sonarcloud_quality_gate" "test" {
 		}
 	]
 }
-	`, name, def, metric, err, op)
+	`, name, metric, err, op)
 
 }
 
@@ -75,3 +81,25 @@ func qualityGateImportCheck(resourceName, name string) resource.TestStep {
 		ImportStateVerify: true,
 	}
 }
+
+func qualityGateImportByIdCheck(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName: resourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(state *terraform.State) (string, error) {
+			return state.RootModule().Resources[resourceName].Primary.ID, nil
+		},
+		ImportStateVerify: true,
+	}
+}
+
+func testAccQualityGateId(resourceName string, id *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
@@ -0,0 +1,707 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceQualityProfileType struct{}
+
+func (r resourceQualityProfileType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a Quality Profile and the rules that are activated on it.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "Implicit Terraform ID, this is equal to the key of the Quality Profile.",
+				Computed:    true,
+			},
+			"key": {
+				Type:        types.StringType,
+				Description: "Key computed by SonarCloud servers.",
+				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Description: "Name of the Quality Profile.",
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 100),
+				},
+			},
+			"language": {
+				Type:        types.StringType,
+				Description: "The key of the language of the Quality Profile, e.g. `java` or `ts`. **Warning:** forces Quality Profile recreation when changed.",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"parent": {
+				Type:        types.StringType,
+				Description: "The name of the Quality Profile to inherit rules from. Must be a profile of the same language.",
+				Optional:    true,
+			},
+			"is_built_in": {
+				Type:        types.BoolType,
+				Description: "Defines whether the Quality Profile is built in.",
+				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"is_default": {
+				Type: types.BoolType,
+				Description: "Defines whether the Quality Profile is the default profile of its language for the organization." +
+					" When set to `false` or destroyed, the built-in profile of the language becomes the default again.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"rules": {
+				Optional: true,
+				Description: "The rules that are activated on this Quality Profile. Rules that are inherited from the parent" +
+					" profile are not included, unless they are overridden here.",
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"rule": {
+						Type:        types.StringType,
+						Description: "The key of the rule, e.g. `java:S1144`.",
+						Required:    true,
+					},
+					"severity": {
+						Type:        types.StringType,
+						Description: "The severity of the rule, must be one of: INFO, MINOR, MAJOR, CRITICAL, BLOCKER.",
+						Required:    true,
+						Validators: []tfsdk.AttributeValidator{
+							allowedOptions("INFO", "MINOR", "MAJOR", "CRITICAL", "BLOCKER"),
+						},
+					},
+					"params": {
+						Type:     types.MapType{ElemType: types.StringType},
+						Optional: true,
+						Description: "The parameters of the rule. Parameters that are not set keep their default value when the rule is activated." +
+							" A parameter that is removed later keeps its last value, until the rule is removed from the profile and added again.",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r resourceQualityProfileType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceQualityProfile{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceQualityProfile struct {
+	p provider
+}
+
+func (r resourceQualityProfile) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfile
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := QualityProfileCreateRequest{
+		Language:     plan.Language.Value,
+		Name:         plan.Name.Value,
+		Organization: r.p.organization,
+	}
+
+	res, err := sonarcloud.PostWithResponse[QualityProfileCreateRequest, QualityProfileCreateResponse](r.p.client, "/qualityprofiles/create", request)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not create the Quality Profile",
+			fmt.Sprintf("The Create request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	key := res.Profile.Key
+
+	if !plan.Parent.Null && plan.Parent.Value != "" {
+		if err := changeQualityProfileParent(r.p.client, r.p.organization, plan.Name.Value, plan.Language.Value, plan.Parent.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not set the parent of the Quality Profile",
+				fmt.Sprintf("The ChangeParent request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	if plan.IsDefault.Value {
+		if err := setDefaultQualityProfile(r.p.client, r.p.organization, plan.Name.Value, plan.Language.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not set Quality Profile as default",
+				fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	for _, rule := range plan.Rules {
+		if err := activateQualityProfileRule(r.p.client, r.p.organization, key, rule); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not activate a Quality Profile rule",
+				fmt.Sprintf("The ActivateRule request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	// Not all values are returned with the create request, so we need to query for them
+	result, ok, err := readQualityProfile(r.p.client, key, plan.Rules)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Quality Profile",
+			fmt.Sprintf("The Quality Profile with key '%s' was created, but could not be found afterwards.", key),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfile) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readQualityProfile(r.p.client, state.Key.Value, state.Rules)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	// Check if the resource exists in the list of retrieved resources
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceQualityProfile) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfile
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := state.Key.Value
+
+	if !state.Name.Equal(plan.Name) {
+		request := QualityProfileRenameRequest{
+			Key:  key,
+			Name: plan.Name.Value,
+		}
+		if err := sonarcloud.Post(r.p.client, "/qualityprofiles/rename", request); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not update the Quality Profile name",
+				fmt.Sprintf("The Rename request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	// Note: all following requests identify the profile by its (possibly new) name
+	if !state.Parent.Equal(plan.Parent) {
+		if err := changeQualityProfileParent(r.p.client, r.p.organization, plan.Name.Value, plan.Language.Value, plan.Parent.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not update the parent of the Quality Profile",
+				fmt.Sprintf("The ChangeParent request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	if !plan.IsDefault.Unknown && !state.IsDefault.Equal(plan.IsDefault) {
+		name := plan.Name.Value
+		if !plan.IsDefault.Value {
+			builtIn, err := findBuiltInQualityProfile(r.p.client, plan.Language.Value)
+			if err != nil {
+				resp.Diagnostics.Append(apiErrorDiagnostic(
+					"Could not find the built-in Quality Profile",
+					fmt.Sprintf("The Search request returned an error: %+v", err),
+					err,
+				))
+				return
+			}
+			name = builtIn.Name
+		}
+
+		if err := setDefaultQualityProfile(r.p.client, r.p.organization, name, plan.Language.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not update the default Quality Profile",
+				fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	toActivate, toUpdate, toDeactivate := diffQualityProfileRules(state.Rules, plan.Rules)
+
+	for _, rule := range toDeactivate {
+		request := QualityProfileDeactivateRuleRequest{
+			Key:          key,
+			Organization: r.p.organization,
+			Rule:         rule.Rule.Value,
+		}
+		if err := sonarcloud.Post(r.p.client, "/qualityprofiles/deactivate_rule", request); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not deactivate a Quality Profile rule",
+				fmt.Sprintf("The DeactivateRule request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+	for _, rule := range append(toActivate, toUpdate...) {
+		if err := activateQualityProfileRule(r.p.client, r.p.organization, key, rule); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not activate a Quality Profile rule",
+				fmt.Sprintf("The ActivateRule request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	// There aren't any return values for non-create operations.
+	result, ok, err := readQualityProfile(r.p.client, key, plan.Rules)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r resourceQualityProfile) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default profile of a language cannot be deleted, so we reset the default to the built-in profile first
+	if state.IsDefault.Value {
+		builtIn, err := findBuiltInQualityProfile(r.p.client, state.Language.Value)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not find the built-in Quality Profile",
+				fmt.Sprintf("The Search request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+
+		if err := setDefaultQualityProfile(r.p.client, r.p.organization, builtIn.Name, state.Language.Value); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not reset the default Quality Profile pre-delete",
+				fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	request := QualityProfileDeleteRequest{
+		Language:       state.Language.Value,
+		Organization:   r.p.organization,
+		QualityProfile: state.Name.Value,
+	}
+	if err := sonarcloud.Post(r.p.client, "/qualityprofiles/delete", request); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not delete the Quality Profile",
+			fmt.Sprintf("The Delete request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceQualityProfile) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+type QualityProfileCreateRequest struct {
+	Language     string `form:"language,omitempty"`
+	Name         string `form:"name,omitempty"`
+	Organization string `form:"organization,omitempty"`
+}
+
+type QualityProfileCreateResponse struct {
+	Profile QualityProfileSearchResponseProfile `json:"profile"`
+}
+
+type QualityProfileRenameRequest struct {
+	Key  string `form:"key,omitempty"`
+	Name string `form:"name,omitempty"`
+}
+
+type QualityProfileChangeParentRequest struct {
+	Language             string `form:"language,omitempty"`
+	Organization         string `form:"organization,omitempty"`
+	ParentQualityProfile string `form:"parentQualityProfile"`
+	QualityProfile       string `form:"qualityProfile,omitempty"`
+}
+
+type QualityProfileSetDefaultRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
+
+type QualityProfileDeleteRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
+
+type QualityProfileActivateRuleRequest struct {
+	Key          string `form:"key,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Params       string `form:"params,omitempty"`
+	Rule         string `form:"rule,omitempty"`
+	Severity     string `form:"severity,omitempty"`
+}
+
+type QualityProfileDeactivateRuleRequest struct {
+	Key          string `form:"key,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Rule         string `form:"rule,omitempty"`
+}
+
+type QualityProfileSearchResponse struct {
+	Profiles []QualityProfileSearchResponseProfile `json:"profiles"`
+}
+
+type QualityProfileSearchResponseProfile struct {
+	Key        string `json:"key,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Language   string `json:"language,omitempty"`
+	ParentKey  string `json:"parentKey,omitempty"`
+	ParentName string `json:"parentName,omitempty"`
+	IsDefault  bool   `json:"isDefault,omitempty"`
+	IsBuiltIn  bool   `json:"isBuiltIn,omitempty"`
+}
+
+type QualityProfileRulesSearchResponse struct {
+	Total   int                                          `json:"total,omitempty"`
+	P       int                                          `json:"p,omitempty"`
+	Ps      int                                          `json:"ps,omitempty"`
+	Actives map[string][]QualityProfileRulesSearchActive `json:"actives,omitempty"`
+}
+
+type QualityProfileRulesSearchActive struct {
+	QProfile string `json:"qProfile,omitempty"`
+	Inherit  string `json:"inherit,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	Params   []struct {
+		Key   string `json:"key,omitempty"`
+		Value string `json:"value,omitempty"`
+	} `json:"params,omitempty"`
+}
+
+// searchQualityProfiles returns the quality profiles of the organization, optionally filtered by language
+func searchQualityProfiles(client *sonarcloud.Client, language string) (*QualityProfileSearchResponse, error) {
+	params := make([]string, 0)
+	if language != "" {
+		params = append(params, "language", language)
+	}
+	return getWithResponse[QualityProfileSearchResponse](client, "/qualityprofiles/search", params...)
+}
+
+// findQualityProfile returns the quality profile with the given key if it exists in the response
+func findQualityProfile(response *QualityProfileSearchResponse, key string) (QualityProfile, bool) {
+	var result QualityProfile
+	ok := false
+	for _, p := range response.Profiles {
+		if p.Key == key {
+			result = QualityProfile{
+				ID:        types.String{Value: p.Key},
+				Key:       types.String{Value: p.Key},
+				Name:      types.String{Value: p.Name},
+				Language:  types.String{Value: p.Language},
+				Parent:    types.String{Value: p.ParentName, Null: p.ParentName == ""},
+				IsBuiltIn: types.Bool{Value: p.IsBuiltIn},
+				IsDefault: types.Bool{Value: p.IsDefault},
+			}
+			ok = true
+			break
+		}
+	}
+	return result, ok
+}
+
+// findBuiltInQualityProfile returns the built-in quality profile of the given language
+func findBuiltInQualityProfile(client *sonarcloud.Client, language string) (*QualityProfileSearchResponseProfile, error) {
+	response, err := searchQualityProfiles(client, language)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range response.Profiles {
+		if p.IsBuiltIn && p.Language == language {
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("no built-in quality profile found for language '%s'", language)
+}
+
+// readQualityProfile returns the quality profile with the given key and the rules that are activated on it.
+// Only the params of the rules that are known in the given rules are kept, so default params don't show up as drift.
+func readQualityProfile(client *sonarcloud.Client, key string, known []QualityProfileRule) (QualityProfile, bool, error) {
+	response, err := searchQualityProfiles(client, "")
+	if err != nil {
+		return QualityProfile{}, false, err
+	}
+
+	result, ok := findQualityProfile(response, key)
+	if !ok {
+		return result, false, nil
+	}
+
+	rules, err := findQualityProfileRules(client, key)
+	if err != nil {
+		return result, false, err
+	}
+	result.Rules = retainKnownRuleParams(rules, known)
+
+	return result, true, nil
+}
+
+// findQualityProfileRules returns the rules that are activated on the profile with the given key, excluding inherited rules
+func findQualityProfileRules(client *sonarcloud.Client, key string) ([]QualityProfileRule, error) {
+	var rules []QualityProfileRule
+	pageSize := 500
+	for page := 1; ; page++ {
+		response, err := getWithResponse[QualityProfileRulesSearchResponse](client, "/rules/search",
+			"qprofile", key,
+			"activation", "true",
+			"f", "actives",
+			"p", strconv.Itoa(page),
+			"ps", strconv.Itoa(pageSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for ruleKey, actives := range response.Actives {
+			for _, active := range actives {
+				if active.QProfile != key || active.Inherit == "INHERITED" {
+					continue
+				}
+
+				params := make(map[string]attr.Value, len(active.Params))
+				for _, param := range active.Params {
+					params[param.Key] = types.String{Value: param.Value}
+				}
+
+				rules = append(rules, QualityProfileRule{
+					Rule:     types.String{Value: ruleKey},
+					Severity: types.String{Value: active.Severity},
+					Params:   types.Map{ElemType: types.StringType, Elems: params, Null: len(params) == 0},
+				})
+			}
+		}
+
+		if page*pageSize >= response.Total {
+			break
+		}
+	}
+
+	// Maps are unordered, sort the rules so the result is stable
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Rule.Value < rules[j].Rule.Value
+	})
+
+	return rules, nil
+}
+
+// retainKnownRuleParams removes the params that are not present in the known rule with the same key.
+// Rules that are not known (e.g. during import) keep all their params.
+func retainKnownRuleParams(rules, known []QualityProfileRule) []QualityProfileRule {
+	for i, rule := range rules {
+		for _, k := range known {
+			if !k.Rule.Equal(rule.Rule) {
+				continue
+			}
+
+			params := make(map[string]attr.Value)
+			if !k.Params.Null && !k.Params.Unknown {
+				for name := range k.Params.Elems {
+					if value, ok := rule.Params.Elems[name]; ok {
+						params[name] = value
+					}
+				}
+			}
+			rules[i].Params = types.Map{ElemType: types.StringType, Elems: params, Null: k.Params.Null}
+			break
+		}
+	}
+	return rules
+}
+
+// activateQualityProfileRule activates the rule on the profile, or updates its severity and params if it is already active
+func activateQualityProfileRule(client *sonarcloud.Client, organization, key string, rule QualityProfileRule) error {
+	request := QualityProfileActivateRuleRequest{
+		Key:          key,
+		Organization: organization,
+		Params:       ruleParamsString(rule.Params),
+		Rule:         rule.Rule.Value,
+		Severity:     rule.Severity.Value,
+	}
+	return sonarcloud.Post(client, "/qualityprofiles/activate_rule", request)
+}
+
+// changeQualityProfileParent sets the parent of a profile, an empty parent removes the inheritance
+func changeQualityProfileParent(client *sonarcloud.Client, organization, name, language, parent string) error {
+	request := QualityProfileChangeParentRequest{
+		Language:             language,
+		Organization:         organization,
+		ParentQualityProfile: parent,
+		QualityProfile:       name,
+	}
+	return sonarcloud.Post(client, "/qualityprofiles/change_parent", request)
+}
+
+// setDefaultQualityProfile sets the profile with the given name as default for its language
+func setDefaultQualityProfile(client *sonarcloud.Client, organization, name, language string) error {
+	request := QualityProfileSetDefaultRequest{
+		Language:       language,
+		Organization:   organization,
+		QualityProfile: name,
+	}
+	return sonarcloud.Post(client, "/qualityprofiles/set_default", request)
+}
+
+// ruleParamsString returns the params in the format expected by the API, i.e. "key1=value1;key2=value2"
+func ruleParamsString(params types.Map) string {
+	if params.Null || params.Unknown {
+		return ""
+	}
+
+	keys := make([]string, 0, len(params.Elems))
+	for k := range params.Elems {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%s", k, params.Elems[k].(types.String).Value)
+	}
+	return strings.Join(pairs, ";")
+}
+
+// Check which Quality Profile rules have to be activated, updated or deactivated
+func diffQualityProfileRules(old, new []QualityProfileRule) (activate, update, deactivate []QualityProfileRule) {
+	activate = []QualityProfileRule{}
+	update = []QualityProfileRule{}
+	deactivate = []QualityProfileRule{}
+
+	for _, r := range new {
+		if existing, ok := findQualityProfileRule(old, r); !ok {
+			activate = append(activate, r)
+		} else if !existing.Severity.Equal(r.Severity) || !existing.Params.Equal(r.Params) {
+			update = append(update, r)
+		}
+	}
+	for _, r := range old {
+		if _, ok := findQualityProfileRule(new, r); !ok {
+			deactivate = append(deactivate, r)
+		}
+	}
+
+	return activate, update, deactivate
+}
+
+// Find a rule with the same rule key in a rule list
+func findQualityProfileRule(list []QualityProfileRule, item QualityProfileRule) (QualityProfileRule, bool) {
+	for _, r := range list {
+		if r.Rule.Equal(item.Rule) {
+			return r, true
+		}
+	}
+	return QualityProfileRule{}, false
+}
@@ -0,0 +1,324 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceQualityProfileSelectionType struct{}
+
+func (r resourceQualityProfileSelectionType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource selects a quality profile for one or more projects",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource, this is equal to the key of the quality profile.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        types.StringType,
+				Description: "The name of the quality profile that is selected for the project(s).",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"language": {
+				Type:        types.StringType,
+				Description: "The key of the language of the quality profile, e.g. `java` or `ts`.",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"profile_key": {
+				Type:        types.StringType,
+				Description: "The key of the quality profile.",
+				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"project_keys": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Description: "The Keys of the projects which have been selected on the referenced quality profile",
+				Required:    true,
+			},
+		},
+	}, nil
+}
+
+func (r resourceQualityProfileSelectionType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceQualityProfileSelection{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceQualityProfileSelection struct {
+	p provider
+}
+
+func (r resourceQualityProfileSelection) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfileSelection
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, s := range plan.ProjectKeys.Elems {
+		request := QualityProfileProjectRequest{
+			Language:       plan.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: plan.Name.Value,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/add_project", request)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not create Quality Profile Selection",
+				fmt.Sprintf("The AddProject request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	result, ok, err := readQualityProfileSelection(r.p.client, plan.Name.Value, plan.Language.Value, plan.ProjectKeys.Elems)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read Quality Profile Selection",
+			fmt.Sprintf("The Projects request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok || !result.ProjectKeys.Equal(plan.ProjectKeys) {
+		resp.Diagnostics.AddError(
+			"Could not find Quality Profile Selection",
+			fmt.Sprintf("Unable to find the project keys: %+v in the selection of the quality profile '%s' (%s)", plan.ProjectKeys.Elems, plan.Name.Value, plan.Language.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfileSelection) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok, err := readQualityProfileSelection(r.p.client, state.Name.Value, state.Language.Value, state.ProjectKeys.Elems)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not Read the Quality Profile Selection",
+			fmt.Sprintf("The Projects request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceQualityProfileSelection) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan QualityProfileSelection
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.ProjectKeys, plan.ProjectKeys)
+
+	for _, s := range toRemove {
+		request := QualityProfileProjectRequest{
+			Language:       state.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: state.Name.Value,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/remove_project", request)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not Deselect the Quality Profile selection",
+				fmt.Sprintf("The RemoveProject request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+	for _, s := range toAdd {
+		request := QualityProfileProjectRequest{
+			Language:       plan.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: plan.Name.Value,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/add_project", request)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not Select the Quality Profile selection",
+				fmt.Sprintf("The AddProject request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	result, ok, err := readQualityProfileSelection(r.p.client, plan.Name.Value, plan.Language.Value, plan.ProjectKeys.Elems)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not Read the Quality Profile Selection",
+			fmt.Sprintf("The Projects request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+	if !ok || !result.ProjectKeys.Equal(plan.ProjectKeys) {
+		resp.Diagnostics.AddError(
+			"Could not find Quality Profile Selection",
+			fmt.Sprintf("Unable to find the project keys: %+v in the selection of the quality profile '%s' (%s)", plan.ProjectKeys.Elems, plan.Name.Value, plan.Language.Value),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfileSelection) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, s := range state.ProjectKeys.Elems {
+		request := QualityProfileProjectRequest{
+			Language:       state.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: state.Name.Value,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/remove_project", request)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Could not Deselect the Quality Profile Selection",
+				fmt.Sprintf("The RemoveProject request returned an error: %+v", err),
+				err,
+			))
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+type QualityProfileProjectRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	Project        string `form:"project,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
+
+type QualityProfileProjectsSearchRequest struct {
+	Key      string
+	Selected string
+}
+
+type QualityProfileProjectsSearchResponseProject struct {
+	Key      string `json:"key,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Selected bool   `json:"selected,omitempty"`
+}
+
+// readQualityProfileSelection returns the selection of the quality profile with the given name and language.
+// The selection only contains the given project keys that are actually selected, other selected projects are ignored.
+func readQualityProfileSelection(client *sonarcloud.Client, name, language string, keys []attr.Value) (QualityProfileSelection, bool, error) {
+	response, err := searchQualityProfiles(client, language)
+	if err != nil {
+		return QualityProfileSelection{}, false, err
+	}
+
+	var profileKey string
+	for _, p := range response.Profiles {
+		if p.Name == name && p.Language == language {
+			profileKey = p.Key
+			break
+		}
+	}
+	if profileKey == "" {
+		return QualityProfileSelection{}, false, nil
+	}
+
+	request := QualityProfileProjectsSearchRequest{
+		Key:      profileKey,
+		Selected: "selected",
+	}
+	projects, err := sonarcloud.GetAll[QualityProfileProjectsSearchRequest, QualityProfileProjectsSearchResponseProject](client, "/qualityprofiles/projects", request, "results")
+	if err != nil {
+		return QualityProfileSelection{}, false, err
+	}
+
+	return QualityProfileSelection{
+		ID:          types.String{Value: profileKey},
+		Name:        types.String{Value: name},
+		Language:    types.String{Value: language},
+		ProfileKey:  types.String{Value: profileKey},
+		ProjectKeys: findQualityProfileSelection(projects, keys),
+	}, true, nil
+}
+
+// findQualityProfileSelection returns the subset of the given project keys that are selected in the response
+func findQualityProfileSelection(projects []QualityProfileProjectsSearchResponseProject, keys []attr.Value) types.Set {
+	projectKeys := make([]attr.Value, 0)
+	for _, k := range keys {
+		for _, p := range projects {
+			if p.Selected && k.Equal(types.String{Value: p.Key}) {
+				projectKeys = append(projectKeys, types.String{Value: p.Key})
+				break
+			}
+		}
+	}
+	return types.Set{ElemType: types.StringType, Elems: projectKeys}
+}
@@ -0,0 +1,56 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckQualityProfileSelection(t *testing.T) {
+	if v := os.Getenv("SONARCLOUD_PROJECT_KEY"); v == "" {
+		t.Fatal("SONARCLOUD_PROJECT_KEY must be set for acceptance tests")
+	}
+}
+
+func TestAccResourceQualityProfileSelection(t *testing.T) {
+	name := "Sonar way"
+	language := "js"
+	project_key := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckQualityProfileSelection(t)
+			testAccSkipWithFakeAPI(t, "quality profiles are not faked")
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityProfileSelectionConfig(name, language, project_key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile_selection.test", "name", name),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile_selection.test", "language", language),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile_selection.test", "project_keys.0", project_key),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityProfileSelectionDestroy,
+	})
+}
+
+func testAccQualityProfileSelectionDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccQualityProfileSelectionConfig(name, language, projectKey string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile_selection" "test" {
+	name = "%s"
+	language = "%s"
+	project_keys = ["%s"]
+}
+	`, name, language, projectKey)
+}
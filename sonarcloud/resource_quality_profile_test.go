@@ -0,0 +1,78 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceQualityProfile(t *testing.T) {
+	prefix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	names := []string{prefix + "_quality_profile_a", prefix + "_quality_profile_b"}
+	language := "js"
+	rule := "javascript:S1481"
+	severities := []string{"MAJOR", "BLOCKER"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipWithFakeAPI(t, "quality profiles and rules are not faked")
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityProfileConfig(names[0], language, rule, severities[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "name", names[0]),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "language", language),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.0.rule", rule),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.0.severity", severities[0]),
+				),
+			},
+			qualityProfileImportCheck("sonarcloud_quality_profile.test"),
+			{
+				Config: testAccQualityProfileConfig(names[1], language, rule, severities[1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "name", names[1]),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "language", language),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.0.rule", rule),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.0.severity", severities[1]),
+				),
+			},
+			qualityProfileImportCheck("sonarcloud_quality_profile.test"),
+		},
+		CheckDestroy: testAccQualityProfileDestroy,
+	})
+}
+
+func testAccQualityProfileDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccQualityProfileConfig(name, language, rule, severity string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile" "test" {
+	name = "%s"
+	language = "%s"
+	rules = [
+		{
+			rule = "%s"
+			severity = "%s"
+		}
+	]
+}
+`, name, language, rule, severity)
+}
+
+func qualityProfileImportCheck(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
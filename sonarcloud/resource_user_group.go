@@ -83,10 +83,11 @@ func (r resourceUserGroup) Create(ctx context.Context, req tfsdk.CreateResourceR
 
 	res, err := r.p.client.UserGroups.Create(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the user_group",
 			fmt.Sprintf("The Create request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -118,10 +119,15 @@ func (r resourceUserGroup) Read(ctx context.Context, req tfsdk.ReadResourceReque
 
 	response, err := r.p.client.UserGroups.SearchAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the user_group",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -151,7 +157,8 @@ func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceR
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed, diags := changedAttrs(req)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -171,10 +178,11 @@ func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceR
 
 	err := r.p.client.UserGroups.Update(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not update the user_group",
 			fmt.Sprintf("The Update request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -184,10 +192,11 @@ func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceR
 
 	response, err := r.p.client.UserGroups.SearchAll(searchRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the user_group",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -213,10 +222,11 @@ func (r resourceUserGroup) Delete(ctx context.Context, req tfsdk.DeleteResourceR
 
 	err := r.p.client.UserGroups.Delete(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the user_group",
 			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -79,10 +79,11 @@ func (r resourceUserGroupMember) Create(ctx context.Context, req tfsdk.CreateRes
 
 	err := r.p.client.UserGroups.AddUser(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the user_group_member.",
 			fmt.Sprintf("The AddUser request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -111,10 +112,15 @@ func (r resourceUserGroupMember) Read(ctx context.Context, req tfsdk.ReadResourc
 
 	response, err := r.p.client.UserGroups.UsersAll(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the user_group_member.",
 			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -149,10 +155,11 @@ func (r resourceUserGroupMember) Delete(ctx context.Context, req tfsdk.DeleteRes
 
 	err := r.p.client.UserGroups.RemoveUser(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the user_group_member.",
 			fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,224 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_groups"
+)
+
+type resourceUserGroupMembersType struct{}
+
+func (r resourceUserGroupMembersType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `This resource manages all members of a user group.
+
+This resource is authoritative: members that are added outside of Terraform are removed on the next apply. Do not use
+it together with ` + "`sonarcloud_user_group_member`" + ` resources for the same group.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The implicit ID of the resource, this is equal to the name of the group.",
+			},
+			"group": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name of the group.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"logins": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The logins of all the users that should be members of the group.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceUserGroupMembersType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceUserGroupMembers{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceUserGroupMembers struct {
+	p provider
+}
+
+func (r resourceUserGroupMembers) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan GroupMembers
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group may already have members, which are replaced by the planned ones
+	current, err := readUserGroupMembers(r.p.client, plan.Group.Value)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the user group members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(current.Logins, plan.Logins)
+	if !r.updateMembers(plan.Group.Value, toAdd, toRemove, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = plan.Group
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceUserGroupMembers) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Retrieve values from state
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All members are read, so members that have been added outside of Terraform show up as drift
+	result, err := readUserGroupMembers(r.p.client, state.Group.Value)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Could not read the user group members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+			err,
+		))
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceUserGroupMembers) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan GroupMembers
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.Logins, plan.Logins)
+	if !r.updateMembers(plan.Group.Value, toAdd, toRemove, &resp.Diagnostics) {
+		return
+	}
+
+	result := plan
+	result.ID = plan.Group
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceUserGroupMembers) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.updateMembers(state.Group.Value, nil, state.Logins.Elems, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceUserGroupMembers) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+}
+
+// updateMembers removes and adds the given logins from and to the group, and returns whether all of them succeeded
+func (r resourceUserGroupMembers) updateMembers(group string, toAdd, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	for _, remove := range toRemove {
+		request := user_groups.RemoveUserRequest{
+			Login:        remove.(types.String).Value,
+			Name:         group,
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.UserGroups.RemoveUser(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the user group member",
+				fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	for _, add := range toAdd {
+		request := user_groups.AddUserRequest{
+			Login:        add.(types.String).Value,
+			Name:         group,
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.UserGroups.AddUser(request); err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the user group member",
+				fmt.Sprintf("The AddUser request returned an error: %+v", err),
+				err,
+			))
+			return false
+		}
+	}
+	return true
+}
+
+// readUserGroupMembers returns all members of the group with the given name
+func readUserGroupMembers(client *sonarcloud.Client, group string) (GroupMembers, error) {
+	response, err := client.UserGroups.UsersAll(user_groups.UsersRequest{Name: group})
+	if err != nil {
+		return GroupMembers{}, err
+	}
+
+	logins := make([]attr.Value, len(response.Users))
+	for i, u := range response.Users {
+		logins[i] = types.String{Value: u.Login}
+	}
+
+	return GroupMembers{
+		ID:     types.String{Value: group},
+		Group:  types.String{Value: group},
+		Logins: types.Set{ElemType: types.StringType, Elems: logins},
+	}, nil
+}
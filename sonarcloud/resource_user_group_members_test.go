@@ -0,0 +1,61 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccUserGroupMembers(t *testing.T) {
+	group := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupMembersConfig(group, []string{login}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test", "id", group),
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test", "group", group),
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test", "logins.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_user_group_members.test", "logins.*", login),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_user_group_members.test",
+				ImportState:       true,
+				ImportStateId:     group,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserGroupMembersConfig(group, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test", "logins.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccUserGroupMembersDestroy,
+	})
+}
+
+func testAccUserGroupMembersDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccUserGroupMembersConfig(group string, logins []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_user_group" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_user_group_members" "test" {
+	group  = sonarcloud_user_group.test.name
+	logins = %s
+}
+`, group, terraformListString(logins))
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
 	"strings"
-	"sync"
-	"time"
 )
 
 type resourceUserGroupPermissionsType struct{}
@@ -51,22 +49,6 @@ func (r resourceUserGroupPermissionsType) GetSchema(_ context.Context) (tfsdk.Sc
 				Description: "List of permissions to grant." +
 					" Available global permissions: [`admin`, `profileadmin`, `gateadmin`, `scan`, `provisioning`]." +
 					" Available project permissions: ['admin`, `scan`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `user`].",
-				Validators: []tfsdk.AttributeValidator{
-					allowedSetOptions(
-						// Global permissions
-						"admin",
-						"profileadmin",
-						"gateadmin",
-						"scan",
-						"provisioning",
-						// Project permissions
-						// Note: admin and scan are project permissions as well
-						"codeviewer",
-						"issueadmin",
-						"securityhotspotadmin",
-						"user",
-					),
-				},
 			},
 		},
 	}, nil
@@ -82,6 +64,12 @@ type resourceUserGroupPermissions struct {
 	p provider
 }
 
+func (r resourceUserGroupPermissions) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		permissionsInScope(path.Root("project_key"), path.Root("permissions")),
+	}
+}
+
 func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -100,45 +88,8 @@ func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.Crea
 		return
 	}
 
-	// Add permissions one by one
-	wg := sync.WaitGroup{}
-	for _, elem := range plan.Permissions.Elems {
-		permission := elem.(types.String).Value
-
-		go func() {
-			wg.Add(1)
-			defer wg.Done()
-
-			request := permissions.AddGroupRequest{
-				GroupName:    plan.Name.Value,
-				Permission:   permission,
-				ProjectKey:   plan.ProjectKey.Value,
-				Organization: r.p.organization,
-			}
-			if err := r.p.client.Permissions.AddGroup(request); err != nil {
-				resp.Diagnostics.AddError(
-					"Could not add group permissions",
-					fmt.Sprintf("The AddGroup request returned an error: %+v", err),
-				)
-				return
-			}
-		}()
-	}
-
-	// Async wait for all requests to be done
-	c := make(chan struct{})
-	go func() {
-		defer close(c)
-		wg.Wait()
-	}()
-
-	// Set ID on success and return error diag on timeout
-	select {
-	case <-c:
-	case <-time.After(30 * time.Second):
-		resp.Diagnostics.AddError("Could not set user group permissions",
-			"The requests to set the permissions timed out.",
-		)
+	if !r.addPermissions(ctx, plan, plan.Permissions.Elems, &resp.Diagnostics) {
+		return
 	}
 
 	plannedPermissions := make([]string, len(plan.Permissions.Elems))
@@ -148,7 +99,7 @@ func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.Crea
 		return
 	}
 
-	backoffConfig := defaultBackoffConfig()
+	backoffConfig := defaultBackoffConfig(ctx)
 
 	group, err := backoff.RetryWithData(
 		func() (*UserGroupPermissions, error) {
@@ -157,10 +108,11 @@ func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.Crea
 		}, backoffConfig)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not find the user group with the planned permissions",
 			fmt.Sprintf("The findUserGroupWithPermissionsSet call returned an error: %+v ", err),
-		)
+			err,
+		))
 	} else {
 		diags = resp.State.Set(ctx, group)
 		resp.Diagnostics.Append(diags...)
@@ -179,10 +131,15 @@ func (r resourceUserGroupPermissions) Read(ctx context.Context, req tfsdk.ReadRe
 	searchRequest := UserGroupPermissionsSearchRequest{ProjectKey: state.ProjectKey.Value}
 	groups, err := sonarcloud.GetAll[UserGroupPermissionsSearchRequest, UserGroupPermissionsSearchResponseGroup](r.p.client, "/permissions/groups", searchRequest, "groups")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not get user group permissions",
 			fmt.Sprintf("The request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -224,36 +181,11 @@ func (r resourceUserGroupPermissions) Update(ctx context.Context, req tfsdk.Upda
 
 	toAdd, toRemove := diffAttrSets(state.Permissions, plan.Permissions)
 
-	for _, remove := range toRemove {
-		removeRequest := permissions.RemoveGroupRequest{
-			GroupName:    state.Name.Value,
-			Permission:   remove.(types.String).Value,
-			ProjectKey:   state.ProjectKey.Value,
-			Organization: r.p.organization,
-		}
-		err := r.p.client.Permissions.RemoveGroup(removeRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not remove the user group permission",
-				fmt.Sprintf("The RemoveGroup request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.removePermissions(ctx, state, toRemove, &resp.Diagnostics) {
+		return
 	}
-	for _, add := range toAdd {
-		addRequest := permissions.AddGroupRequest{
-			GroupName:    plan.Name.Value,
-			Permission:   add.(types.String).Value,
-			ProjectKey:   plan.ProjectKey.Value,
-			Organization: r.p.organization,
-		}
-		if err := r.p.client.Permissions.AddGroup(addRequest); err != nil {
-			resp.Diagnostics.AddError(
-				"Could not add the user group permission",
-				fmt.Sprintf("The AddGroup request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.addPermissions(ctx, plan, toAdd, &resp.Diagnostics) {
+		return
 	}
 
 	plannedPermissions := make([]string, len(plan.Permissions.Elems))
@@ -263,7 +195,7 @@ func (r resourceUserGroupPermissions) Update(ctx context.Context, req tfsdk.Upda
 		return
 	}
 
-	backoffConfig := defaultBackoffConfig()
+	backoffConfig := defaultBackoffConfig(ctx)
 
 	group, err := backoff.RetryWithData(
 		func() (*UserGroupPermissions, error) {
@@ -272,10 +204,11 @@ func (r resourceUserGroupPermissions) Update(ctx context.Context, req tfsdk.Upda
 		}, backoffConfig)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not find the user group with the planned permissions",
 			fmt.Sprintf("The findUserGroupWithPermissionsSet call returned an error: %+v ", err),
-		)
+			err,
+		))
 	} else {
 		diags = resp.State.Set(ctx, group)
 		resp.Diagnostics.Append(diags...)
@@ -290,21 +223,8 @@ func (r resourceUserGroupPermissions) Delete(ctx context.Context, req tfsdk.Dele
 		return
 	}
 
-	for _, remove := range state.Permissions.Elems {
-		removeRequest := permissions.RemoveGroupRequest{
-			GroupName:    state.Name.Value,
-			Permission:   remove.(types.String).Value,
-			ProjectKey:   state.ProjectKey.Value,
-			Organization: r.p.organization,
-		}
-		err := r.p.client.Permissions.RemoveGroup(removeRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not remove the user group permission",
-				fmt.Sprintf("The RemoveGroup request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.removePermissions(ctx, state, state.Permissions.Elems, &resp.Diagnostics) {
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
@@ -383,3 +303,49 @@ func findUserGroup(groups []UserGroupPermissionsSearchResponseGroup, name string
 	}
 	return nil, false
 }
+
+// addPermissions grants the given permissions to the group, and returns whether all of them were granted
+func (r resourceUserGroupPermissions) addPermissions(ctx context.Context, plan UserGroupPermissions, toAdd []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toAdd, func(add attr.Value) error {
+		request := permissions.AddGroupRequest{
+			GroupName:    plan.Name.Value,
+			Permission:   add.(types.String).Value,
+			ProjectKey:   plan.ProjectKey.Value,
+			Organization: r.p.organization,
+		}
+		return r.p.client.Permissions.AddGroup(request)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the user group permission",
+				fmt.Sprintf("The AddGroup request for permission '%s' returned an error: %+v", toAdd[i].(types.String).Value, err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
+
+// removePermissions revokes the given permissions from the group, and returns whether all of them were revoked
+func (r resourceUserGroupPermissions) removePermissions(ctx context.Context, state UserGroupPermissions, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toRemove, func(remove attr.Value) error {
+		request := permissions.RemoveGroupRequest{
+			GroupName:    state.Name.Value,
+			Permission:   remove.(types.String).Value,
+			ProjectKey:   state.ProjectKey.Value,
+			Organization: r.p.organization,
+		}
+		return r.p.client.Permissions.RemoveGroup(request)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the user group permission",
+				fmt.Sprintf("The RemoveGroup request for permission '%s' returned an error: %+v", toRemove[i].(types.String).Value, err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
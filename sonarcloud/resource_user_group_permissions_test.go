@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -21,6 +22,18 @@ func TestAccUserGroupPermissions(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig("", name, []string{
+					"codeviewer",
+				}),
+				ExpectError: regexp.MustCompile("Permission \"codeviewer\" can not be granted on the organization"),
+			},
+			{
+				Config: testAccPermissionConfig(projectKey, name, []string{
+					"provisioning",
+				}),
+				ExpectError: regexp.MustCompile("Permission \"provisioning\" can not be granted on a project"),
+			},
 			{
 				Config: testAccPermissionConfig("", name, []string{
 					"provisioning",
@@ -12,8 +12,6 @@ import (
 	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
 	"strings"
-	"sync"
-	"time"
 )
 
 type resourceUserPermissionsType struct{}
@@ -51,22 +49,6 @@ func (r resourceUserPermissionsType) GetSchema(_ context.Context) (tfsdk.Schema,
 				Description: "List of permissions to grant." +
 					" Available global permissions: [`admin`, `profileadmin`, `gateadmin`, `scan`, `provisioning`]." +
 					" Available project permissions: ['admin`, `scan`, `codeviewer`, `issueadmin`, `securityhotspotadmin`, `user`].",
-				Validators: []tfsdk.AttributeValidator{
-					allowedSetOptions(
-						// Global permissions
-						"admin",
-						"profileadmin",
-						"gateadmin",
-						"scan",
-						"provisioning",
-						// Project permissions
-						// Note: admin and scan are project permissions as well
-						"codeviewer",
-						"issueadmin",
-						"securityhotspotadmin",
-						"user",
-					),
-				},
 			},
 			"avatar": {
 				Type:        types.StringType,
@@ -87,6 +69,12 @@ type resourceUserPermissions struct {
 	p provider
 }
 
+func (r resourceUserPermissions) ConfigValidators(_ context.Context) []tfsdk.ResourceConfigValidator {
+	return []tfsdk.ResourceConfigValidator{
+		permissionsInScope(path.Root("project_key"), path.Root("permissions")),
+	}
+}
+
 func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -105,45 +93,8 @@ func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateRes
 		return
 	}
 
-	// Add permissions one by one
-	wg := sync.WaitGroup{}
-	for _, elem := range plan.Permissions.Elems {
-		permission := elem.(types.String).Value
-
-		go func() {
-			wg.Add(1)
-			defer wg.Done()
-
-			request := permissions.AddUserRequest{
-				Login:        plan.Login.Value,
-				Permission:   permission,
-				ProjectKey:   plan.ProjectKey.Value,
-				Organization: r.p.organization,
-			}
-			if err := r.p.client.Permissions.AddUser(request); err != nil {
-				resp.Diagnostics.AddError(
-					"Could not add user permissions",
-					fmt.Sprintf("The AddUser request returned an error: %+v", err),
-				)
-				return
-			}
-		}()
-	}
-
-	// Async wait for all requests to be done
-	c := make(chan struct{})
-	go func() {
-		defer close(c)
-		wg.Wait()
-	}()
-
-	// Set ID on success and return error diag on timeout
-	select {
-	case <-c:
-	case <-time.After(30 * time.Second):
-		resp.Diagnostics.AddError("Could not set user user permissions",
-			"The requests to set the permissions timed out.",
-		)
+	if !r.addPermissions(ctx, plan, plan.Permissions.Elems, &resp.Diagnostics) {
+		return
 	}
 
 	plannedPermissions := make([]string, len(plan.Permissions.Elems))
@@ -153,7 +104,7 @@ func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateRes
 		return
 	}
 
-	backoffConfig := defaultBackoffConfig()
+	backoffConfig := defaultBackoffConfig(ctx)
 
 	user, err := backoff.RetryWithData(
 		func() (*UserPermissions, error) {
@@ -162,10 +113,11 @@ func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateRes
 		}, backoffConfig)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not find the user with the planned permissions",
 			fmt.Sprintf("The findUserWithPermissionsSet call returned an error: %+v ", err),
-		)
+			err,
+		))
 	} else {
 		diags = resp.State.Set(ctx, user)
 		resp.Diagnostics.Append(diags...)
@@ -184,10 +136,15 @@ func (r resourceUserPermissions) Read(ctx context.Context, req tfsdk.ReadResourc
 	searchRequest := UserPermissionsSearchRequest{ProjectKey: state.ProjectKey.Value}
 	users, err := sonarcloud.GetAll[UserPermissionsSearchRequest, UserPermissionsSearchResponseUser](r.p.client, "/permissions/users", searchRequest, "users")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not get user permissions",
 			fmt.Sprintf("The request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -230,36 +187,11 @@ func (r resourceUserPermissions) Update(ctx context.Context, req tfsdk.UpdateRes
 
 	toAdd, toRemove := diffAttrSets(state.Permissions, plan.Permissions)
 
-	for _, remove := range toRemove {
-		removeRequest := permissions.RemoveUserRequest{
-			Login:        state.Login.Value,
-			Organization: r.p.organization,
-			Permission:   remove.(types.String).Value,
-			ProjectKey:   state.ProjectKey.Value,
-		}
-		err := r.p.client.Permissions.RemoveUser(removeRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not remove the permission",
-				fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.removePermissions(ctx, state, toRemove, &resp.Diagnostics) {
+		return
 	}
-	for _, add := range toAdd {
-		addRequest := permissions.AddUserRequest{
-			Login:        plan.Login.Value,
-			Permission:   add.(types.String).Value,
-			ProjectKey:   plan.ProjectKey.Value,
-			Organization: r.p.organization,
-		}
-		if err := r.p.client.Permissions.AddUser(addRequest); err != nil {
-			resp.Diagnostics.AddError(
-				"Could not add the user permission",
-				fmt.Sprintf("The AddUser request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.addPermissions(ctx, plan, toAdd, &resp.Diagnostics) {
+		return
 	}
 
 	plannedPermissions := make([]string, len(plan.Permissions.Elems))
@@ -269,7 +201,7 @@ func (r resourceUserPermissions) Update(ctx context.Context, req tfsdk.UpdateRes
 		return
 	}
 
-	backoffConfig := defaultBackoffConfig()
+	backoffConfig := defaultBackoffConfig(ctx)
 
 	user, err := backoff.RetryWithData(
 		func() (*UserPermissions, error) {
@@ -277,10 +209,11 @@ func (r resourceUserPermissions) Update(ctx context.Context, req tfsdk.UpdateRes
 		}, backoffConfig)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not find the user with the planned permissions",
 			fmt.Sprintf("The findUserWithPermissionsSet call returned an error: %+v ", err),
-		)
+			err,
+		))
 	} else {
 		diags = resp.State.Set(ctx, user)
 		resp.Diagnostics.Append(diags...)
@@ -295,21 +228,8 @@ func (r resourceUserPermissions) Delete(ctx context.Context, req tfsdk.DeleteRes
 		return
 	}
 
-	for _, remove := range state.Permissions.Elems {
-		removeRequest := permissions.RemoveUserRequest{
-			Login:        state.Login.Value,
-			Organization: r.p.organization,
-			Permission:   remove.(types.String).Value,
-			ProjectKey:   state.ProjectKey.Value,
-		}
-		err := r.p.client.Permissions.RemoveUser(removeRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not remove the user permission",
-				fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
-			)
-			return
-		}
+	if !r.removePermissions(ctx, state, state.Permissions.Elems, &resp.Diagnostics) {
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
@@ -391,3 +311,49 @@ func findUser(users []UserPermissionsSearchResponseUser, login string) (*UserPer
 	}
 	return nil, false
 }
+
+// addPermissions grants the given permissions to the user, and returns whether all of them were granted
+func (r resourceUserPermissions) addPermissions(ctx context.Context, plan UserPermissions, toAdd []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toAdd, func(add attr.Value) error {
+		request := permissions.AddUserRequest{
+			Login:        plan.Login.Value,
+			Permission:   add.(types.String).Value,
+			ProjectKey:   plan.ProjectKey.Value,
+			Organization: r.p.organization,
+		}
+		return r.p.client.Permissions.AddUser(request)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not add the user permission",
+				fmt.Sprintf("The AddUser request for permission '%s' returned an error: %+v", toAdd[i].(types.String).Value, err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
+
+// removePermissions revokes the given permissions from the user, and returns whether all of them were revoked
+func (r resourceUserPermissions) removePermissions(ctx context.Context, state UserPermissions, toRemove []attr.Value, diags *diag.Diagnostics) bool {
+	errs := forEachConcurrently(ctx, toRemove, func(remove attr.Value) error {
+		request := permissions.RemoveUserRequest{
+			Login:        state.Login.Value,
+			Organization: r.p.organization,
+			Permission:   remove.(types.String).Value,
+			ProjectKey:   state.ProjectKey.Value,
+		}
+		return r.p.client.Permissions.RemoveUser(request)
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags.Append(apiErrorDiagnostic(
+				"Could not remove the user permission",
+				fmt.Sprintf("The RemoveUser request for permission '%s' returned an error: %+v", toRemove[i].(types.String).Value, err),
+				err,
+			))
+		}
+	}
+	return !diags.HasError()
+}
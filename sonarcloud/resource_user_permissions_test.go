@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -20,6 +21,18 @@ func TestAccUserPermissions(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPermissionConfig("", login, []string{
+					"codeviewer",
+				}),
+				ExpectError: regexp.MustCompile("Permission \"codeviewer\" can not be granted on the organization"),
+			},
+			{
+				Config: testAccUserPermissionConfig(projectKey, login, []string{
+					"provisioning",
+				}),
+				ExpectError: regexp.MustCompile("Permission \"provisioning\" can not be granted on a project"),
+			},
 			{
 				Config: testAccUserPermissionConfig("", login, []string{
 					"provisioning",
@@ -82,10 +82,11 @@ func (r resourceUserToken) Create(ctx context.Context, req tfsdk.CreateResourceR
 
 	res, err := r.p.client.UserTokens.Generate(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the user_token",
 			fmt.Sprintf("The Generate request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -116,10 +117,15 @@ func (r resourceUserToken) Read(ctx context.Context, req tfsdk.ReadResourceReque
 
 	response, err := r.p.client.UserTokens.Search(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the user_token",
 			fmt.Sprintf("The Search request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -153,10 +159,11 @@ func (r resourceUserToken) Delete(ctx context.Context, req tfsdk.DeleteResourceR
 
 	err := r.p.client.UserTokens.Revoke(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the user_token",
 			fmt.Sprintf("The Revoke request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
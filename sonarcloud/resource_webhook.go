@@ -94,10 +94,11 @@ func (r resourceWebhook) Create(ctx context.Context, req tfsdk.CreateResourceReq
 
 	res, err := r.p.client.Webhooks.Create(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not create the webhook",
 			fmt.Sprintf("The Create request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -132,10 +133,15 @@ func (r resourceWebhook) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 
 	response, err := r.p.client.Webhooks.List(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the webhooks",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -176,10 +182,11 @@ func (r resourceWebhook) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 	err := r.p.client.Webhooks.Update(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not update the webhook",
 			fmt.Sprintf("The Update request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -192,10 +199,11 @@ func (r resourceWebhook) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 	response, err := r.p.client.Webhooks.List(listRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not read the webhooks",
 			fmt.Sprintf("The List request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
@@ -220,10 +228,11 @@ func (r resourceWebhook) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 	}
 	err := r.p.client.Webhooks.Delete(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Could not delete the webhook",
 			fmt.Sprintf("The Delete request returned an error: %+v", err),
-		)
+			err,
+		))
 		return
 	}
 
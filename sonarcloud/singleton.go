@@ -0,0 +1,40 @@
+package sonarcloud
+
+import "sync"
+
+// singletonClaims keeps track of the singleton resources that have been planned since the provider was last
+// configured. Terraform configures the provider at the start of every graph walk and plans each resource instance
+// once per walk, so a second claim for the same singleton means that it has been declared more than once.
+type singletonClaims struct {
+	mu     sync.Mutex
+	claims map[string]int
+}
+
+func newSingletonClaims() *singletonClaims {
+	return &singletonClaims{claims: make(map[string]int)}
+}
+
+// claim registers a planned instance of the named singleton and reports whether it is the only instance
+func (s *singletonClaims) claim(name string) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.claims[name]++
+	return s.claims[name] == 1
+}
+
+// reset forgets all claims
+func (s *singletonClaims) reset() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.claims = make(map[string]int)
+}
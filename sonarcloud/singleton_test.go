@@ -0,0 +1,27 @@
+package sonarcloud
+
+import "testing"
+
+func TestSingletonClaims(t *testing.T) {
+	claims := newSingletonClaims()
+
+	if !claims.claim("a") {
+		t.Errorf("expected the first claim of a to succeed")
+	}
+	if !claims.claim("b") {
+		t.Errorf("expected the first claim of b to succeed")
+	}
+	if claims.claim("a") {
+		t.Errorf("expected the second claim of a to fail")
+	}
+
+	claims.reset()
+	if !claims.claim("a") {
+		t.Errorf("expected the first claim of a after a reset to succeed")
+	}
+
+	var unset *singletonClaims
+	if !unset.claim("a") || !unset.claim("a") {
+		t.Errorf("expected claims on a nil registry to always succeed")
+	}
+}
@@ -0,0 +1,370 @@
+package sonarcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Timeouts configures how long each operation of a resource may take
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsAttribute returns the attribute that is added to the schema of every resource by withTimeouts
+func timeoutsAttribute() tfsdk.Attribute {
+	operation := func(name string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+			Description: fmt.Sprintf("The time after which the %s operation is aborted, as a duration like `30s` or `10m`."+
+				" Defaults to no timeout.", name),
+			Validators: []tfsdk.AttributeValidator{
+				duration(),
+			},
+		}
+	}
+
+	return tfsdk.Attribute{
+		Optional: true,
+		Description: "The timeouts of the operations of this resource. All requests of an operation, including their" +
+			" retries, are aborted once its timeout is reached.",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": operation("create"),
+			"read":   operation("read"),
+			"update": operation("update"),
+			"delete": operation("delete"),
+		}),
+	}
+}
+
+// withTimeouts adds a timeouts attribute to the given resource type. The resources of the type do not know about the
+// attribute: it is removed from their config, plan and state, and their operations run with the configured timeout.
+// The operations that change a resource also skip the read cache, so that they always see their own changes.
+func withTimeouts(resourceType tfsdk.ResourceType) tfsdk.ResourceType {
+	return timeoutsResourceType{ResourceType: resourceType}
+}
+
+type timeoutsResourceType struct {
+	tfsdk.ResourceType
+}
+
+func (t timeoutsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	schema, diags := t.ResourceType.GetSchema(ctx)
+	if diags.HasError() {
+		return schema, diags
+	}
+
+	attributes := make(map[string]tfsdk.Attribute, len(schema.Attributes)+1)
+	for name, attribute := range schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes["timeouts"] = timeoutsAttribute()
+	schema.Attributes = attributes
+
+	return schema, diags
+}
+
+func (t timeoutsResourceType) NewResource(ctx context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	schema, diags := t.ResourceType.GetSchema(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	decorated, d := t.GetSchema(ctx)
+	diags.Append(d...)
+
+	return timeoutsResource{
+		resourceType: t.ResourceType,
+		p:            p.(*provider),
+		schema:       schema,
+		decorated:    decorated,
+	}, diags
+}
+
+type timeoutsResource struct {
+	resourceType tfsdk.ResourceType
+	p            *provider
+	schema       tfsdk.Schema
+	decorated    tfsdk.Schema
+}
+
+func (r timeoutsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	ctx, cancel := r.withTimeout(ctx, req.Plan.GetAttribute, "create", &resp.Diagnostics)
+	defer cancel()
+	ctx = withoutReadCache(ctx)
+
+	config := r.config(ctx, req.Config, &resp.Diagnostics)
+	plan := r.plan(ctx, req.Plan, &resp.Diagnostics)
+	state := r.state(ctx, resp.State, &resp.Diagnostics)
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner := tfsdk.CreateResourceResponse{State: state}
+	resource.Create(ctx, tfsdk.CreateResourceRequest{Config: config, Plan: plan, ProviderMeta: req.ProviderMeta}, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+	r.checkTimeout(ctx, "create", &resp.Diagnostics)
+
+	resp.State.Raw = r.decorate(ctx, inner.State.Raw, req.Plan.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	ctx, cancel := r.withTimeout(ctx, req.State.GetAttribute, "read", &resp.Diagnostics)
+	defer cancel()
+
+	state := r.state(ctx, req.State, &resp.Diagnostics)
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner := tfsdk.ReadResourceResponse{State: r.state(ctx, resp.State, &resp.Diagnostics)}
+	resource.Read(ctx, tfsdk.ReadResourceRequest{State: state, ProviderMeta: req.ProviderMeta}, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+	r.checkTimeout(ctx, "read", &resp.Diagnostics)
+
+	resp.State.Raw = r.decorate(ctx, inner.State.Raw, req.State.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	ctx, cancel := r.withTimeout(ctx, req.Plan.GetAttribute, "update", &resp.Diagnostics)
+	defer cancel()
+	ctx = withoutReadCache(ctx)
+
+	config := r.config(ctx, req.Config, &resp.Diagnostics)
+	plan := r.plan(ctx, req.Plan, &resp.Diagnostics)
+	state := r.state(ctx, req.State, &resp.Diagnostics)
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner := tfsdk.UpdateResourceResponse{State: r.state(ctx, resp.State, &resp.Diagnostics)}
+	resource.Update(ctx, tfsdk.UpdateResourceRequest{Config: config, Plan: plan, State: state, ProviderMeta: req.ProviderMeta}, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+	r.checkTimeout(ctx, "update", &resp.Diagnostics)
+
+	resp.State.Raw = r.decorate(ctx, inner.State.Raw, req.Plan.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	ctx, cancel := r.withTimeout(ctx, req.State.GetAttribute, "delete", &resp.Diagnostics)
+	defer cancel()
+	ctx = withoutReadCache(ctx)
+
+	state := r.state(ctx, req.State, &resp.Diagnostics)
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner := tfsdk.DeleteResourceResponse{State: r.state(ctx, resp.State, &resp.Diagnostics)}
+	resource.Delete(ctx, tfsdk.DeleteResourceRequest{State: state, ProviderMeta: req.ProviderMeta}, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+	r.checkTimeout(ctx, "delete", &resp.Diagnostics)
+
+	resp.State.Raw = r.decorate(ctx, inner.State.Raw, req.State.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	modifier, ok := resource.(tfsdk.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	config := r.config(ctx, req.Config, &resp.Diagnostics)
+	plan := r.plan(ctx, req.Plan, &resp.Diagnostics)
+	state := r.state(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner := tfsdk.ModifyResourcePlanResponse{
+		Plan:            r.plan(ctx, resp.Plan, &resp.Diagnostics),
+		RequiresReplace: resp.RequiresReplace,
+	}
+	modifier.ModifyPlan(ctx, tfsdk.ModifyResourcePlanRequest{Config: config, Plan: plan, State: state, ProviderMeta: req.ProviderMeta}, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+	resp.RequiresReplace = inner.RequiresReplace
+
+	resp.Plan.Raw = r.decorate(ctx, inner.Plan.Raw, resp.Plan.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	resource := r.resource(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	importer, ok := resource.(tfsdk.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	inner := tfsdk.ImportResourceStateResponse{State: r.state(ctx, resp.State, &resp.Diagnostics)}
+	importer.ImportState(ctx, req, &inner)
+	resp.Diagnostics.Append(inner.Diagnostics...)
+
+	resp.State.Raw = r.decorate(ctx, inner.State.Raw, resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r timeoutsResource) ConfigValidators(ctx context.Context) []tfsdk.ResourceConfigValidator {
+	// The validators only access the config by path, so they can be given the config with timeouts
+	var diags diag.Diagnostics
+	if resource, ok := r.resource(ctx, &diags).(tfsdk.ResourceWithConfigValidators); ok {
+		return resource.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+// resource returns the decorated resource, of which all requests to the API are bound to ctx
+func (r timeoutsResource) resource(ctx context.Context, diags *diag.Diagnostics) tfsdk.Resource {
+	p := *r.p
+	if p.configured {
+		p.client = p.clientWithContext(ctx)
+	}
+
+	resource, d := r.resourceType.NewResource(ctx, &p)
+	diags.Append(d...)
+	return resource
+}
+
+// withTimeout returns a context that is done once the configured timeout of the operation is reached
+func (r timeoutsResource) withTimeout(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, operation string, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	var timeouts *Timeouts
+	diags.Append(getAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if diags.HasError() || timeouts == nil {
+		return context.WithCancel(ctx)
+	}
+
+	var timeout types.String
+	switch operation {
+	case "create":
+		timeout = timeouts.Create
+	case "read":
+		timeout = timeouts.Read
+	case "update":
+		timeout = timeouts.Update
+	case "delete":
+		timeout = timeouts.Delete
+	}
+	if timeout.Null || timeout.Unknown {
+		return context.WithCancel(ctx)
+	}
+
+	d, err := time.ParseDuration(timeout.Value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(operation),
+			"Invalid Duration",
+			fmt.Sprintf("The %s timeout could not be parsed: %+v", operation, err),
+		)
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+// checkTimeout explains the errors of an operation if they were caused by reaching its timeout
+func (r timeoutsResource) checkTimeout(ctx context.Context, operation string, diags *diag.Diagnostics) {
+	if diags.HasError() && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		diags.AddError(
+			fmt.Sprintf("Timeout while running the %s operation", operation),
+			fmt.Sprintf("The %s operation did not finish within its timeout. Increase `timeouts.%s` if the operation needs more time.", operation, operation),
+		)
+	}
+}
+
+func (r timeoutsResource) config(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	return tfsdk.Config{Schema: r.schema, Raw: r.undecorate(ctx, config.Raw, diags)}
+}
+
+func (r timeoutsResource) plan(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{Schema: r.schema, Raw: r.undecorate(ctx, plan.Raw, diags)}
+}
+
+func (r timeoutsResource) state(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{Schema: r.schema, Raw: r.undecorate(ctx, state.Raw, diags)}
+}
+
+// undecorate returns the value of the decorated schema as a value of the schema of the resource, without timeouts
+func (r timeoutsResource) undecorate(ctx context.Context, value tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	typ := r.schema.TerraformType(ctx)
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		diags.AddError(
+			"Could not remove the timeouts from the resource",
+			fmt.Sprintf("This should not happen and is an error in the provider: %+v", err),
+		)
+		return tftypes.NewValue(typ, nil)
+	}
+
+	// The attributes are shared with the given value, so they must not be modified in place
+	without := make(map[string]tftypes.Value, len(attributes))
+	for name, attribute := range attributes {
+		if name != "timeouts" {
+			without[name] = attribute
+		}
+	}
+
+	return tftypes.NewValue(typ, without)
+}
+
+// decorate returns the value of the schema of the resource as a value of the decorated schema, taking the timeouts from
+// the given value of the decorated schema
+func (r timeoutsResource) decorate(ctx context.Context, value tftypes.Value, timeoutsFrom tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	typ := r.decorated.TerraformType(ctx)
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		diags.AddError(
+			"Could not add the timeouts to the resource",
+			fmt.Sprintf("This should not happen and is an error in the provider: %+v", err),
+		)
+		return tftypes.NewValue(typ, nil)
+	}
+
+	with := make(map[string]tftypes.Value, len(attributes)+1)
+	for name, attribute := range attributes {
+		with[name] = attribute
+	}
+	with["timeouts"] = tftypes.NewValue(typ.(tftypes.Object).AttributeTypes["timeouts"], nil)
+	var from map[string]tftypes.Value
+	if !timeoutsFrom.IsNull() && timeoutsFrom.IsKnown() && timeoutsFrom.As(&from) == nil {
+		if timeouts, ok := from["timeouts"]; ok {
+			with["timeouts"] = timeouts
+		}
+	}
+
+	return tftypes.NewValue(typ, with)
+}
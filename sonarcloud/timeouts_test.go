@@ -0,0 +1,136 @@
+package sonarcloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// timeoutsTestResourceType is a resource that does not know about timeouts, and records the deadline of its create
+type timeoutsTestResourceType struct {
+	deadline *time.Time
+}
+
+type timeoutsTestResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (t timeoutsTestResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id":   {Type: types.StringType, Computed: true},
+			"name": {Type: types.StringType, Required: true},
+		},
+	}, nil
+}
+
+func (t timeoutsTestResourceType) NewResource(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return timeoutsTestResource(t), nil
+}
+
+type timeoutsTestResource struct {
+	deadline *time.Time
+}
+
+func (r timeoutsTestResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if deadline, ok := ctx.Deadline(); ok {
+		*r.deadline = deadline
+	}
+
+	var plan timeoutsTestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r timeoutsTestResource) Read(_ context.Context, _ tfsdk.ReadResourceRequest, _ *tfsdk.ReadResourceResponse) {
+}
+
+func (r timeoutsTestResource) Update(_ context.Context, _ tfsdk.UpdateResourceRequest, _ *tfsdk.UpdateResourceResponse) {
+}
+
+func (r timeoutsTestResource) Delete(ctx context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func TestWithTimeouts(t *testing.T) {
+	ctx := context.Background()
+	var deadline time.Time
+	resourceType := withTimeouts(timeoutsTestResourceType{deadline: &deadline})
+
+	schema, diags := resourceType.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if _, ok := schema.Attributes["timeouts"]; !ok {
+		t.Fatalf("expected the schema to have a timeouts attribute")
+	}
+
+	resource, diags := resourceType.NewResource(ctx, &provider{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	typ := schema.TerraformType(ctx).(tftypes.Object)
+	timeoutsType := typ.AttributeTypes["timeouts"].(tftypes.Object)
+	timeouts := tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+		"create": tftypes.NewValue(tftypes.String, "1h"),
+		"read":   tftypes.NewValue(tftypes.String, nil),
+		"update": tftypes.NewValue(tftypes.String, nil),
+		"delete": tftypes.NewValue(tftypes.String, nil),
+	})
+	planned := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":     tftypes.NewValue(tftypes.String, "test"),
+		"timeouts": timeouts,
+	})
+
+	req := tfsdk.CreateResourceRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: planned},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: planned},
+	}
+	resp := tfsdk.CreateResourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(typ, nil)}}
+	resource.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if until := time.Until(deadline); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("expected the create to run with a deadline of 1h, got: %s", until)
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	var create types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts").AtName("create"), &create)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if id.Value != "test" {
+		t.Errorf("expected the state of the resource to be kept, got id: %q", id.Value)
+	}
+	if create.Value != "1h" {
+		t.Errorf("expected the timeouts to be kept in the state, got create timeout: %q", create.Value)
+	}
+
+	deleteReq := tfsdk.DeleteResourceRequest{State: resp.State}
+	deleteResp := tfsdk.DeleteResourceResponse{State: resp.State}
+	resource.Delete(ctx, deleteReq, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", deleteResp.Diagnostics)
+	}
+	if !deleteResp.State.Raw.IsNull() {
+		t.Errorf("expected the state to be removed, got: %s", deleteResp.State.Raw)
+	}
+}
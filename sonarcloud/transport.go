@@ -0,0 +1,283 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// apiURLTransport rewrites requests for the default SonarCloud API to the configured API URL.
+// The client library has the API URL hard-coded, so this is the only place where we can change it.
+type apiURLTransport struct {
+	base       http.RoundTripper
+	apiURL     *url.URL
+	defaultAPI *url.URL
+}
+
+// newAPIURLTransport returns a transport that sends all requests for the default API to apiURL instead
+func newAPIURLTransport(base http.RoundTripper, apiURL string) (*apiURLTransport, error) {
+	target, err := parseAPIURL(apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultAPI, err := url.Parse(sonarcloud.API)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse default API URL: %+v", err)
+	}
+
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &apiURLTransport{
+		base:       base,
+		apiURL:     target,
+		defaultAPI: defaultAPI,
+	}, nil
+}
+
+// RoundTrip sends the request to the configured API URL if it was meant for the default API
+func (t *apiURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.defaultAPI.Host || !strings.HasPrefix(req.URL.Path, t.defaultAPI.Path) {
+		return t.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the original request
+	rewritten := req.Clone(req.Context())
+	rewritten.URL.Scheme = t.apiURL.Scheme
+	rewritten.URL.Host = t.apiURL.Host
+	rewritten.URL.Path = t.apiURL.Path + strings.TrimPrefix(req.URL.Path, t.defaultAPI.Path)
+	rewritten.URL.RawPath = ""
+	rewritten.Host = ""
+
+	return t.base.RoundTrip(rewritten)
+}
+
+// parseAPIURL parses and validates the API URL, stripping any trailing slashes
+func parseAPIURL(apiURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimRight(apiURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse API URL: %+v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("API URL must use the http or https scheme, got: %q", apiURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("API URL must contain a host, got: %q", apiURL)
+	}
+	return u, nil
+}
+
+// newHTTPClient returns a copy of the given client (or a new one if nil) that sends its requests to apiURL and logs them
+func newHTTPClient(client *http.Client, apiURL string) (*http.Client, error) {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+
+	// Requests are logged after they have been rewritten, so that the logs show where they were actually sent
+	transport, err := newAPIURLTransport(newLoggingTransport(c.Transport), apiURL)
+	if err != nil {
+		return nil, err
+	}
+	c.Transport = transport
+
+	return &c, nil
+}
+
+// retryConfig configures how often and how long requests to the API are retried
+type retryConfig struct {
+	MaxRetries     int
+	WaitMin        time.Duration
+	WaitMax        time.Duration
+	RequestTimeout time.Duration
+}
+
+// defaultRetryConfig returns the retry configuration that is used when the provider does not configure one
+func defaultRetryConfig() retryConfig {
+	return retryConfig{
+		MaxRetries:     5,
+		WaitMin:        1 * time.Second,
+		WaitMax:        30 * time.Second,
+		RequestTimeout: 1 * time.Minute,
+	}
+}
+
+// retryTransport retries requests that were rate limited or that reached a temporarily unavailable API. It waits for
+// the duration of the Retry-After header when the API sends one, and backs off exponentially otherwise.
+// Failed connections and unavailable APIs are only retried for GET requests, as other requests might have been
+// processed already.
+type retryTransport struct {
+	base   http.RoundTripper
+	config retryConfig
+}
+
+// RoundTrip sends the request, retrying it until it succeeds, MaxRetries is reached or the request context is done
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	rewindable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = t.config.WaitMin
+	b.MaxInterval = t.config.WaitMax
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.attemptRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if !rewindable || attempt >= t.config.MaxRetries || ctx.Err() != nil || !shouldRetry(req, resp, err) {
+			if resp != nil {
+				resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+			} else {
+				cancel()
+			}
+			return resp, err
+		}
+
+		wait := b.NextBackOff()
+		if after, ok := retryAfter(resp); ok {
+			wait = after
+		}
+
+		fields := requestFields(attemptReq)
+		fields["wait_ms"] = wait.Milliseconds()
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+		}
+		tflog.Warn(ctx, "Retrying SonarCloud API request", fields)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		cancel()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptRequest returns a copy of the request with a fresh body, bound to the timeout of a single attempt
+func (t *retryTransport) attemptRequest(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	parent := withRequestAttempt(req.Context(), attempt+1)
+	ctx, cancel := context.WithCancel(parent)
+	if t.config.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, t.config.RequestTimeout)
+	}
+
+	// A RoundTripper must not modify the original request
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, fmt.Errorf("could not rewind the request body: %+v", err)
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, cancel, nil
+}
+
+// shouldRetry reports whether the response, or the error, of a request is worth another attempt. A rate limited
+// request was not processed, so it is always retried. Other failures are only retried for GET requests, because a
+// gateway error does not tell whether the API has processed the request before it failed.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Method == http.MethodGet
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return req.Method == http.MethodGet
+	default:
+		return false
+	}
+}
+
+// retryAfter returns the duration to wait according to the Retry-After header of the response, if it has one
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// cancelOnCloseBody releases the context of a request attempt once its response body has been read
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// withRetries returns a copy of the given client that retries its requests according to the config
+func withRetries(client *http.Client, config retryConfig) *http.Client {
+	c := *client
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c.Transport = &retryTransport{base: base, config: config}
+	return &c
+}
+
+// contextTransport binds every request to a context, as the client library does not accept one
+type contextTransport struct {
+	base http.RoundTripper
+	ctx  context.Context
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+// withContext returns a copy of the given client that binds all of its requests to ctx
+func withContext(ctx context.Context, client *http.Client) *http.Client {
+	c := *client
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c.Transport = &contextTransport{base: base, ctx: ctx}
+	return &c
+}
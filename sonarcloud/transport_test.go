@@ -0,0 +1,238 @@
+package sonarcloud
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+)
+
+func TestAPIURLTransport(t *testing.T) {
+	var gotPath, gotOrganization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotOrganization = r.URL.Query().Get("organization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"paging":{"pageIndex":1,"pageSize":100,"total":0},"components":[]}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(server.Client(), server.URL+"/custom/api/")
+	if err != nil {
+		t.Fatalf("could not create http client: %+v", err)
+	}
+
+	client := sonarcloud.NewClient("my-org", "token", httpClient)
+	if _, err := client.Projects.SearchAll(projects.SearchRequest{}); err != nil {
+		t.Fatalf("request to the fake API failed: %+v", err)
+	}
+
+	if gotPath != "/custom/api/projects/search" {
+		t.Errorf("expected request path '/custom/api/projects/search', got: %q", gotPath)
+	}
+	if gotOrganization != "my-org" {
+		t.Errorf("expected organization 'my-org', got: %q", gotOrganization)
+	}
+}
+
+func TestParseAPIURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		apiURL  string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", apiURL: sonarcloud.API, want: sonarcloud.API},
+		{name: "trailing slash", apiURL: "https://sonarqube.us/api/", want: "https://sonarqube.us/api"},
+		{name: "plain http", apiURL: "http://127.0.0.1:9000/api", want: "http://127.0.0.1:9000/api"},
+		{name: "missing scheme", apiURL: "sonarcloud.io/api", wantErr: true},
+		{name: "unsupported scheme", apiURL: "ftp://sonarcloud.io/api", wantErr: true},
+		{name: "missing host", apiURL: "https:///api", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAPIURL(tt.apiURL)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error for %q, got: %s", tt.apiURL, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("expected %q, got: %q", tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := withRetries(server.Client(), retryConfig{MaxRetries: 5, WaitMin: time.Millisecond, WaitMax: time.Millisecond})
+	resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader("name=test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status %d, got: %d", http.StatusNoContent, resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got: %d", calls)
+	}
+	for i, body := range bodies {
+		if body != "name=test" {
+			t.Errorf("expected the body of call %d to be resent, got: %q", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransportMaxRetries(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := withRetries(server.Client(), retryConfig{MaxRetries: 2, WaitMin: time.Millisecond, WaitMax: time.Millisecond})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got: %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 1 call and 2 retries, got %d calls", calls)
+	}
+}
+
+func TestRetryTransportNotRetried(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := withRetries(server.Client(), retryConfig{MaxRetries: 5, WaitMin: time.Millisecond, WaitMax: time.Millisecond})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	resp.Body.Close()
+
+	if calls != 1 {
+		t.Errorf("expected a client error not to be retried, got %d calls", calls)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "rate limited get", method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{name: "rate limited post", method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{name: "unavailable get", method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{name: "unavailable post", method: http.MethodPost, status: http.StatusServiceUnavailable},
+		{name: "gateway timeout post", method: http.MethodPost, status: http.StatusGatewayTimeout},
+		{name: "failed connection get", method: http.MethodGet, err: errors.New("connection refused"), want: true},
+		{name: "failed connection post", method: http.MethodPost, err: errors.New("connection refused")},
+		{name: "server error get", method: http.MethodGet, status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "https://sonarcloud.io/api/projects/create", nil)
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+
+			if got := shouldRetry(req, resp, tt.err); got != tt.want {
+				t.Errorf("expected %t, got: %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := withContext(ctx, withRetries(server.Client(), defaultRetryConfig()))
+	start := time.Now()
+	_, err := client.Get(server.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline of the context to be exceeded, got: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the wait for the Retry-After header to be aborted, took: %s", elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		wantOk bool
+	}{
+		{name: "missing", header: ""},
+		{name: "seconds", header: "12", want: 12 * time.Second, wantOk: true},
+		{name: "date in the past", header: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0, wantOk: true},
+		{name: "invalid", header: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			got, ok := retryAfter(resp)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("expected (%s, %t), got: (%s, %t)", tt.want, tt.wantOk, got, ok)
+			}
+		})
+	}
+}
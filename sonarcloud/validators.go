@@ -3,8 +3,13 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Copied from https://www.terraform.io/plugin/framework/validation
@@ -150,3 +155,280 @@ func (v allowedSetOptionsValidator) Validate(ctx context.Context, req tfsdk.Vali
 		}
 	}
 }
+
+type lowercaseSetElementsValidator struct{}
+
+func lowercaseSetElements() *lowercaseSetElementsValidator {
+	return &lowercaseSetElementsValidator{}
+}
+
+func (v lowercaseSetElementsValidator) Description(_ context.Context) string {
+	return "values in set must be lowercase"
+}
+
+func (v lowercaseSetElementsValidator) MarkdownDescription(_ context.Context) string {
+	return "values in set must be lowercase"
+}
+
+func (v lowercaseSetElementsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &set)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if set.Unknown || set.Null {
+		return
+	}
+
+	for _, elem := range set.Elems {
+		val, ok := elem.(types.String)
+		if !ok || val.Unknown || val.Null {
+			continue
+		}
+
+		if val.Value != strings.ToLower(val.Value) {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid String Element in Set",
+				fmt.Sprintf("Element must be lowercase, got: %s.", val.Value),
+			)
+
+			return
+		}
+	}
+}
+
+type durationValidator struct{}
+
+func duration() *durationValidator {
+	return &durationValidator{}
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration like 30s, 5m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a duration like `30s`, `5m` or `1h30m`"
+}
+
+// Validate checks that the string attribute can be parsed as a positive duration
+func (v durationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	if d, err := time.ParseDuration(str.Value); err != nil || d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Duration",
+			fmt.Sprintf("String must be a positive duration like 30s, 5m or 1h30m, got: %s.", str.Value),
+		)
+
+		return
+	}
+}
+
+type exactlyOneOfValidator struct {
+	Paths []path.Path
+}
+
+func exactlyOneOf(paths ...path.Path) *exactlyOneOfValidator {
+	return &exactlyOneOfValidator{Paths: paths}
+}
+
+func (v exactlyOneOfValidator) names() string {
+	names := make([]string, len(v.Paths))
+	for i, p := range v.Paths {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("exactly one of [%s] must be set", v.names())
+}
+
+func (v exactlyOneOfValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("exactly one of `[%s]` must be set", v.names())
+}
+
+// ValidateResource checks that exactly one of the attributes at Paths is set.
+// Unknown values are skipped, as they might still turn out to be null.
+func (v exactlyOneOfValidator) ValidateResource(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	set := 0
+	unknown := false
+	for _, p := range v.Paths {
+		var value attr.Value
+		diags := req.Config.GetAttribute(ctx, p, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		if value.IsUnknown() {
+			unknown = true
+		} else if !value.IsNull() {
+			set++
+		}
+	}
+
+	if set > 1 || (set == 0 && !unknown) {
+		resp.Diagnostics.AddAttributeError(
+			v.Paths[0],
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Exactly one of [%s] must be set, got: %d.", v.names(), set),
+		)
+
+		return
+	}
+}
+
+type newCodePeriodValidator struct{}
+
+func newCodePeriod() *newCodePeriodValidator {
+	return &newCodePeriodValidator{}
+}
+
+func (v newCodePeriodValidator) Description(_ context.Context) string {
+	return "value must be a positive number of days for NUMBER_OF_DAYS, a branch name for REFERENCE_BRANCH and unset for PREVIOUS_VERSION"
+}
+
+func (v newCodePeriodValidator) MarkdownDescription(_ context.Context) string {
+	return "`value` must be a positive number of days for `NUMBER_OF_DAYS`, a branch name for `REFERENCE_BRANCH` and unset for `PREVIOUS_VERSION`"
+}
+
+// ValidateResource checks that the value attribute matches the type attribute of a new code period
+func (v newCodePeriodValidator) ValidateResource(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var periodType types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("type"), &periodType)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	var value types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("value"), &value)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if periodType.Unknown || periodType.Null || value.Unknown {
+		return
+	}
+
+	switch periodType.Value {
+	case "PREVIOUS_VERSION":
+		if !value.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid New Code Period Value",
+				fmt.Sprintf("A value must not be set for type %s, got: %s.", periodType.Value, value.Value),
+			)
+		}
+	case "NUMBER_OF_DAYS":
+		if days, err := strconv.Atoi(value.Value); value.Null || err != nil || days < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid New Code Period Value",
+				fmt.Sprintf("The value must be a positive number of days for type %s, got: %q.", periodType.Value, value.Value),
+			)
+		}
+	case "REFERENCE_BRANCH":
+		if value.Null || value.Value == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid New Code Period Value",
+				fmt.Sprintf("The value must be the name of a branch for type %s.", periodType.Value),
+			)
+		}
+	}
+}
+
+type permissionsInScopeValidator struct {
+	ProjectKey  path.Path
+	Permissions path.Path
+}
+
+// permissionsInScope checks that the permissions at the given path can be granted on the organization when the project
+// key is not set or empty, and on the project when it is. The permissions are either a set, or a map that is keyed by permission.
+func permissionsInScope(projectKey path.Path, permissions path.Path) *permissionsInScopeValidator {
+	return &permissionsInScopeValidator{ProjectKey: projectKey, Permissions: permissions}
+}
+
+func (v permissionsInScopeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("permissions must be one of %v if %s is not set, and one of %v if it is", globalPermissions, v.ProjectKey, projectPermissions)
+}
+
+func (v permissionsInScopeValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("permissions must be one of `%v` if `%s` is not set, and one of `%v` if it is", globalPermissions, v.ProjectKey, projectPermissions)
+}
+
+// ValidateResource checks the permissions against the scope that follows from the project key.
+// When the project key is unknown, the permissions are checked against all available permissions instead.
+func (v permissionsInScopeValidator) ValidateResource(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var projectKey types.String
+	diags := req.Config.GetAttribute(ctx, v.ProjectKey, &projectKey)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	var value attr.Value
+	diags = req.Config.GetAttribute(ctx, v.Permissions, &value)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if value.IsUnknown() || value.IsNull() {
+		return
+	}
+
+	options, scope := allPermissions(), "the organization or a project"
+	if !projectKey.Unknown {
+		if projectKey.Null || projectKey.Value == "" {
+			options, scope = globalPermissions, "the organization"
+		} else {
+			options, scope = projectPermissions, "a project"
+		}
+	}
+
+	allowed := make(map[string]struct{})
+	for _, option := range options {
+		allowed[option] = struct{}{}
+	}
+
+	check := func(p path.Path, permission string) {
+		if _, ok := allowed[permission]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Invalid Permission for Scope",
+				fmt.Sprintf("Permission %q can not be granted on %s, it must be one of %v.", permission, scope, options),
+			)
+		}
+	}
+
+	switch permissions := value.(type) {
+	case types.Set:
+		for _, elem := range permissions.Elems {
+			if permission, ok := elem.(types.String); ok && !permission.Unknown && !permission.Null {
+				check(v.Permissions, permission.Value)
+			}
+		}
+	case types.Map:
+		for permission := range permissions.Elems {
+			check(v.Permissions.AtMapKey(permission), permission)
+		}
+	}
+}
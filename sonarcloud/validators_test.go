@@ -0,0 +1,176 @@
+package sonarcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributeValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator tfsdk.AttributeValidator
+		value     attr.Value
+		wantError bool
+	}{
+		{name: "length null", validator: stringLengthBetween(1, 3), value: types.String{Null: true}},
+		{name: "length unknown", validator: stringLengthBetween(1, 3), value: types.String{Unknown: true}},
+		{name: "length valid", validator: stringLengthBetween(1, 3), value: types.String{Value: "abc"}},
+		{name: "length too short", validator: stringLengthBetween(1, 3), value: types.String{Value: ""}, wantError: true},
+		{name: "length too long", validator: stringLengthBetween(1, 3), value: types.String{Value: "abcd"}, wantError: true},
+
+		{name: "options null", validator: allowedOptions("a", "b"), value: types.String{Null: true}},
+		{name: "options unknown", validator: allowedOptions("a", "b"), value: types.String{Unknown: true}},
+		{name: "options valid", validator: allowedOptions("a", "b"), value: types.String{Value: "b"}},
+		{name: "options invalid", validator: allowedOptions("a", "b"), value: types.String{Value: "c"}, wantError: true},
+
+		{name: "set options null", validator: allowedSetOptions("a", "b"), value: types.Set{ElemType: types.StringType, Null: true}},
+		{name: "set options unknown", validator: allowedSetOptions("a", "b"), value: types.Set{ElemType: types.StringType, Unknown: true}},
+		{name: "set options empty", validator: allowedSetOptions("a", "b"), value: stringSet()},
+		{name: "set options valid", validator: allowedSetOptions("a", "b"), value: stringSet("a", "b")},
+		{name: "set options invalid", validator: allowedSetOptions("a", "b"), value: stringSet("a", "c"), wantError: true},
+
+		{name: "lowercase null", validator: lowercaseSetElements(), value: types.Set{ElemType: types.StringType, Null: true}},
+		{name: "lowercase unknown", validator: lowercaseSetElements(), value: types.Set{ElemType: types.StringType, Unknown: true}},
+		{name: "lowercase unknown element", validator: lowercaseSetElements(), value: types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Unknown: true}}}},
+		{name: "lowercase valid", validator: lowercaseSetElements(), value: stringSet("a", "b-c")},
+		{name: "lowercase invalid", validator: lowercaseSetElements(), value: stringSet("a", "B"), wantError: true},
+
+		{name: "duration null", validator: duration(), value: types.String{Null: true}},
+		{name: "duration unknown", validator: duration(), value: types.String{Unknown: true}},
+		{name: "duration valid", validator: duration(), value: types.String{Value: "1h30m"}},
+		{name: "duration zero", validator: duration(), value: types.String{Value: "0s"}},
+		{name: "duration negative", validator: duration(), value: types.String{Value: "-5m"}, wantError: true},
+		{name: "duration without unit", validator: duration(), value: types.String{Value: "30"}, wantError: true},
+		{name: "duration invalid", validator: duration(), value: types.String{Value: "soon"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: tt.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			tt.validator.Validate(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error to be %v, got: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testConfig returns a config of the attributes with the given values, where a missing value is null
+func testConfig(attributes []string, values map[string]tftypes.Value) tfsdk.Config {
+	schema := tfsdk.Schema{Attributes: map[string]tfsdk.Attribute{}}
+	attributeTypes := map[string]tftypes.Type{}
+	raw := map[string]tftypes.Value{}
+	for _, name := range attributes {
+		schema.Attributes[name] = tfsdk.Attribute{Type: typeOf(name), Optional: true}
+		attributeTypes[name] = typeOf(name).TerraformType(context.Background())
+		raw[name] = tftypes.NewValue(attributeTypes[name], nil)
+	}
+	for name, value := range values {
+		raw[name] = value
+	}
+
+	return tfsdk.Config{
+		Schema: schema,
+		Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, raw),
+	}
+}
+
+// typeOf returns the type of the attributes of testConfig
+func typeOf(name string) attr.Type {
+	switch name {
+	case "permissions":
+		return types.SetType{ElemType: types.StringType}
+	case "permission_map":
+		return types.MapType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+func str(value interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, value)
+}
+
+func strs(values ...string) tftypes.Value {
+	elems := make([]tftypes.Value, len(values))
+	for i, v := range values {
+		elems[i] = str(v)
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+}
+
+func TestResourceValidators(t *testing.T) {
+	exactlyOne := exactlyOneOf(path.Root("a"), path.Root("b"))
+	permissions := permissionsInScope(path.Root("project_key"), path.Root("permissions"))
+	permissionMap := permissionsInScope(path.Root("project_key"), path.Root("permission_map"))
+	unknown := tftypes.UnknownValue
+
+	tests := []struct {
+		name       string
+		validator  tfsdk.ResourceConfigValidator
+		attributes []string
+		values     map[string]tftypes.Value
+		wantError  bool
+	}{
+		{name: "exactly one of first", validator: exactlyOne, attributes: []string{"a", "b"}, values: map[string]tftypes.Value{"a": str("x")}},
+		{name: "exactly one of second", validator: exactlyOne, attributes: []string{"a", "b"}, values: map[string]tftypes.Value{"b": str("x")}},
+		{name: "exactly one of unknown", validator: exactlyOne, attributes: []string{"a", "b"}, values: map[string]tftypes.Value{"a": str(unknown)}},
+		{name: "exactly one of none", validator: exactlyOne, attributes: []string{"a", "b"}, wantError: true},
+		{name: "exactly one of both", validator: exactlyOne, attributes: []string{"a", "b"}, values: map[string]tftypes.Value{"a": str("x"), "b": str("y")}, wantError: true},
+		{name: "exactly one of set and unknown", validator: exactlyOne, attributes: []string{"a", "b"}, values: map[string]tftypes.Value{"a": str("x"), "b": str(unknown)}},
+
+		{name: "previous version", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("PREVIOUS_VERSION")}},
+		{name: "previous version with value", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("PREVIOUS_VERSION"), "value": str("30")}, wantError: true},
+		{name: "number of days", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("NUMBER_OF_DAYS"), "value": str("30")}},
+		{name: "number of days without value", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("NUMBER_OF_DAYS")}, wantError: true},
+		{name: "number of days zero", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("NUMBER_OF_DAYS"), "value": str("0")}, wantError: true},
+		{name: "number of days not a number", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("NUMBER_OF_DAYS"), "value": str("main")}, wantError: true},
+		{name: "number of days unknown", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("NUMBER_OF_DAYS"), "value": str(unknown)}},
+		{name: "reference branch", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("REFERENCE_BRANCH"), "value": str("main")}},
+		{name: "reference branch empty", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str("REFERENCE_BRANCH"), "value": str("")}, wantError: true},
+		{name: "unknown type", validator: newCodePeriod(), attributes: []string{"type", "value"}, values: map[string]tftypes.Value{"type": str(unknown), "value": str("main")}},
+
+		{name: "organization permissions", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"permissions": strs("admin", "gateadmin")}},
+		{name: "organization permissions with empty project", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"project_key": str(""), "permissions": strs("gateadmin")}},
+		{name: "project permission on organization", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"permissions": strs("issueadmin")}, wantError: true},
+		{name: "project permissions", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"project_key": str("project"), "permissions": strs("admin", "issueadmin")}},
+		{name: "organization permission on project", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"project_key": str("project"), "permissions": strs("gateadmin")}, wantError: true},
+		{name: "unknown project", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"project_key": str(unknown), "permissions": strs("gateadmin", "issueadmin")}},
+		{name: "unknown project with invalid permission", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"project_key": str(unknown), "permissions": strs("superuser")}, wantError: true},
+		{name: "unknown permissions", validator: permissions, attributes: []string{"project_key", "permissions"}, values: map[string]tftypes.Value{"permissions": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, unknown)}},
+		{
+			name: "permission map", validator: permissionMap, attributes: []string{"project_key", "permission_map"},
+			values: map[string]tftypes.Value{"project_key": str("project"), "permission_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"issueadmin": str("x")})},
+		},
+		{
+			name: "invalid permission map", validator: permissionMap, attributes: []string{"project_key", "permission_map"},
+			values:    map[string]tftypes.Value{"permission_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"issueadmin": str("x")})},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tfsdk.ValidateResourceConfigRequest{Config: testConfig(tt.attributes, tt.values)}
+			resp := &tfsdk.ValidateResourceConfigResponse{}
+
+			tt.validator.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error to be %v, got: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}